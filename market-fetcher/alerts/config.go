@@ -0,0 +1,154 @@
+package alerts
+
+import (
+	"fmt"
+	"time"
+)
+
+// Rule types
+const (
+	// RuleTypeChange fires when price moves more than Threshold percent within Window
+	RuleTypeChange = "change"
+	// RuleTypeAbove fires when price crosses above Threshold
+	RuleTypeAbove = "above"
+	// RuleTypeBelow fires when price crosses below Threshold
+	RuleTypeBelow = "below"
+)
+
+const (
+	// DefaultCurrency is used by rules without explicit currency
+	DefaultCurrency = "usd"
+	// DefaultCooldown is a minimal interval between alerts of the same rule
+	DefaultCooldown = 15 * time.Minute
+	// DefaultMaxAttempts is a number of webhook delivery attempts
+	DefaultMaxAttempts = 5
+	// DefaultRetryDelay is a delay before the first delivery retry, doubled on each retry
+	DefaultRetryDelay = time.Second
+)
+
+// Config represents price alerts configuration
+type Config struct {
+	// Rules evaluated on every prices update
+	Rules []Rule `yaml:"rules"`
+
+	// Webhooks alerts are delivered to
+	Webhooks []Webhook `yaml:"webhooks"`
+
+	// MaxAttempts is a number of webhook delivery attempts
+	MaxAttempts int `yaml:"max_attempts"`
+
+	// RetryDelay is a delay before the first delivery retry, doubled on each retry
+	RetryDelay time.Duration `yaml:"retry_delay"`
+}
+
+// Rule defines a condition on a token price
+type Rule struct {
+	Name      string        `yaml:"name"`
+	TokenID   string        `yaml:"token_id"`
+	Currency  string        `yaml:"currency"`  // defaults to usd
+	Type      string        `yaml:"type"`      // change, above or below
+	Threshold float64       `yaml:"threshold"` // percent for change rules, price otherwise
+	Window    time.Duration `yaml:"window"`    // time window of change rules
+	Cooldown  time.Duration `yaml:"cooldown"`  // minimal interval between alerts
+	Webhook   string        `yaml:"webhook"`   // webhook name, empty means all webhooks
+}
+
+// Webhook is an HTTP endpoint alerts are posted to
+type Webhook struct {
+	Name   string `yaml:"name"`
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"` // HMAC-SHA256 signing secret, empty disables signing
+}
+
+// Validate validates alerts configuration
+func (c Config) Validate() error {
+	webhooks := make(map[string]bool)
+	for i, webhook := range c.Webhooks {
+		if webhook.Name == "" {
+			return fmt.Errorf("webhook at index %d: name cannot be empty", i)
+		}
+		if webhook.URL == "" {
+			return fmt.Errorf("webhook '%s': url cannot be empty", webhook.Name)
+		}
+		if webhooks[webhook.Name] {
+			return fmt.Errorf("webhook '%s' is defined more than once", webhook.Name)
+		}
+		webhooks[webhook.Name] = true
+	}
+
+	rules := make(map[string]bool)
+	for _, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if rules[rule.Name] {
+			return fmt.Errorf("rule '%s' is defined more than once", rule.Name)
+		}
+		if rule.Webhook != "" && !webhooks[rule.Webhook] {
+			return fmt.Errorf("rule '%s': unknown webhook '%s'", rule.Name, rule.Webhook)
+		}
+		rules[rule.Name] = true
+	}
+
+	return nil
+}
+
+// GetMaxAttempts returns the number of delivery attempts or default value
+func (c Config) GetMaxAttempts() int {
+	if c.MaxAttempts > 0 {
+		return c.MaxAttempts
+	}
+	return DefaultMaxAttempts
+}
+
+// GetRetryDelay returns the delay before the first delivery retry or default value
+func (c Config) GetRetryDelay() time.Duration {
+	if c.RetryDelay > 0 {
+		return c.RetryDelay
+	}
+	return DefaultRetryDelay
+}
+
+// Validate validates a single rule
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("rule name cannot be empty")
+	}
+	if r.TokenID == "" {
+		return fmt.Errorf("rule '%s': token_id cannot be empty", r.Name)
+	}
+
+	switch r.Type {
+	case RuleTypeChange:
+		if r.Threshold <= 0 {
+			return fmt.Errorf("rule '%s': threshold must be greater than 0", r.Name)
+		}
+		if r.Window <= 0 {
+			return fmt.Errorf("rule '%s': window must be greater than 0", r.Name)
+		}
+	case RuleTypeAbove, RuleTypeBelow:
+		if r.Threshold <= 0 {
+			return fmt.Errorf("rule '%s': threshold must be greater than 0", r.Name)
+		}
+	default:
+		return fmt.Errorf("rule '%s': unknown type '%s'", r.Name, r.Type)
+	}
+
+	return nil
+}
+
+// GetCurrency returns the rule currency or default value
+func (r Rule) GetCurrency() string {
+	if r.Currency != "" {
+		return r.Currency
+	}
+	return DefaultCurrency
+}
+
+// GetCooldown returns the rule cooldown or default value
+func (r Rule) GetCooldown() time.Duration {
+	if r.Cooldown > 0 {
+		return r.Cooldown
+	}
+	return DefaultCooldown
+}
@@ -0,0 +1,94 @@
+package alerts
+
+import (
+	"math"
+	"time"
+)
+
+// Alert is a payload delivered to webhooks when a rule fires
+type Alert struct {
+	Rule        string    `json:"rule"`
+	TokenID     string    `json:"token_id"`
+	Currency    string    `json:"currency"`
+	Type        string    `json:"type"`
+	Threshold   float64   `json:"threshold"`
+	Price       float64   `json:"price"`
+	Change      float64   `json:"change,omitempty"` // percent change within window, for change rules
+	Window      string    `json:"window,omitempty"`
+	TriggeredAt time.Time `json:"triggered_at"`
+}
+
+// priceSample is a price observed at a point of time
+type priceSample struct {
+	at    time.Time
+	price float64
+}
+
+// ruleState keeps evaluation state of a rule between prices updates
+type ruleState struct {
+	samples   []priceSample // prices within window, for change rules
+	active    bool          // condition held on the previous evaluation
+	lastFired time.Time
+}
+
+// evaluate updates state with the new price and returns an alert if the rule fires.
+// A rule fires once when its condition starts to hold and is re-armed when it stops holding,
+// alerts within cooldown of the previous one are suppressed.
+func (s *ruleState) evaluate(rule Rule, price float64, now time.Time) *Alert {
+	var condition bool
+	var change float64
+
+	switch rule.Type {
+	case RuleTypeChange:
+		s.addSample(price, now, rule.Window)
+		base := s.samples[0].price
+		if base > 0 {
+			change = (price - base) / base * 100
+		}
+		condition = math.Abs(change) >= rule.Threshold
+	case RuleTypeAbove:
+		condition = price >= rule.Threshold
+	case RuleTypeBelow:
+		condition = price <= rule.Threshold
+	}
+
+	if !condition {
+		s.active = false
+		return nil
+	}
+
+	if s.active || (!s.lastFired.IsZero() && now.Sub(s.lastFired) < rule.GetCooldown()) {
+		return nil
+	}
+
+	s.active = true
+	s.lastFired = now
+
+	alert := &Alert{
+		Rule:        rule.Name,
+		TokenID:     rule.TokenID,
+		Currency:    rule.GetCurrency(),
+		Type:        rule.Type,
+		Threshold:   rule.Threshold,
+		Price:       price,
+		TriggeredAt: now,
+	}
+	if rule.Type == RuleTypeChange {
+		alert.Change = change
+		alert.Window = rule.Window.String()
+	}
+
+	return alert
+}
+
+// addSample appends price and drops samples older than window
+func (s *ruleState) addSample(price float64, now time.Time, window time.Duration) {
+	s.samples = append(s.samples, priceSample{at: now, price: price})
+
+	cutoff := now.Add(-window)
+	first := 0
+	for first < len(s.samples)-1 && s.samples[first].at.Before(cutoff) {
+		first++
+	}
+	s.samples = s.samples[first:]
+}
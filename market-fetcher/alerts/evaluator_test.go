@@ -0,0 +1,68 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleState_Above(t *testing.T) {
+	rule := Rule{Name: "btc-100k", TokenID: "bitcoin", Type: RuleTypeAbove, Threshold: 100000, Cooldown: time.Minute}
+	state := &ruleState{}
+	now := time.Now()
+
+	assert.Nil(t, state.evaluate(rule, 99000, now))
+
+	alert := state.evaluate(rule, 100500, now.Add(time.Second))
+	require.NotNil(t, alert)
+	assert.Equal(t, "btc-100k", alert.Rule)
+	assert.Equal(t, "usd", alert.Currency)
+	assert.Equal(t, 100500.0, alert.Price)
+
+	// Condition keeps holding, alert is not repeated
+	assert.Nil(t, state.evaluate(rule, 101000, now.Add(2*time.Second)))
+
+	// Crossing again within cooldown is suppressed
+	assert.Nil(t, state.evaluate(rule, 99000, now.Add(3*time.Second)))
+	assert.Nil(t, state.evaluate(rule, 100500, now.Add(4*time.Second)))
+
+	// Crossing after cooldown fires again
+	assert.Nil(t, state.evaluate(rule, 99000, now.Add(2*time.Minute)))
+	assert.NotNil(t, state.evaluate(rule, 100500, now.Add(3*time.Minute)))
+}
+
+func TestRuleState_Below(t *testing.T) {
+	rule := Rule{Name: "eth-low", TokenID: "ethereum", Type: RuleTypeBelow, Threshold: 2000}
+	state := &ruleState{}
+
+	assert.Nil(t, state.evaluate(rule, 2100, time.Now()))
+	assert.NotNil(t, state.evaluate(rule, 1999, time.Now()))
+}
+
+func TestRuleState_Change(t *testing.T) {
+	rule := Rule{Name: "btc-move", TokenID: "bitcoin", Type: RuleTypeChange, Threshold: 5, Window: time.Hour}
+	state := &ruleState{}
+	now := time.Now()
+
+	assert.Nil(t, state.evaluate(rule, 100, now))
+	assert.Nil(t, state.evaluate(rule, 103, now.Add(10*time.Minute)))
+
+	alert := state.evaluate(rule, 94, now.Add(20*time.Minute))
+	require.NotNil(t, alert)
+	assert.InDelta(t, -6.0, alert.Change, 1e-9)
+	assert.Equal(t, "1h0m0s", alert.Window)
+}
+
+func TestRuleState_ChangeOutsideWindowIsIgnored(t *testing.T) {
+	rule := Rule{Name: "btc-move", TokenID: "bitcoin", Type: RuleTypeChange, Threshold: 5, Window: time.Hour}
+	state := &ruleState{}
+	now := time.Now()
+
+	assert.Nil(t, state.evaluate(rule, 100, now))
+	assert.Nil(t, state.evaluate(rule, 104, now.Add(50*time.Minute)))
+
+	// The first sample is out of window, change is measured from 104
+	assert.Nil(t, state.evaluate(rule, 106, now.Add(70*time.Minute)))
+}
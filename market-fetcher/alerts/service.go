@@ -0,0 +1,244 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/status-im/market-proxy/events"
+	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+// Service evaluates price alert rules on every prices update and delivers alerts to webhooks
+type Service struct {
+	config        Config
+	pricesService interfaces.IPricesService
+	sender        *WebhookSender
+	subscription  events.ISubscription
+	cancel        context.CancelFunc
+
+	// Names of rules from config, used to bound the fired alerts metric cardinality
+	configuredRules map[string]bool
+
+	// Rules by name with their evaluation state
+	rules struct {
+		sync.Mutex
+		byName map[string]Rule
+		states map[string]*ruleState
+	}
+}
+
+// NewService creates a new alerts service with rules from config
+func NewService(config Config, pricesService interfaces.IPricesService) *Service {
+	service := &Service{
+		config:          config,
+		pricesService:   pricesService,
+		sender:          NewWebhookSender(config),
+		configuredRules: make(map[string]bool, len(config.Rules)),
+	}
+
+	service.rules.byName = make(map[string]Rule)
+	service.rules.states = make(map[string]*ruleState)
+	for _, rule := range config.Rules {
+		service.rules.byName[rule.Name] = rule
+		service.rules.states[rule.Name] = &ruleState{}
+		service.configuredRules[rule.Name] = true
+	}
+
+	return service
+}
+
+// Start implements core.Interface
+func (s *Service) Start(ctx context.Context) error {
+	if s.pricesService == nil {
+		return fmt.Errorf("prices service dependency not provided")
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.sender.Start(ctx)
+
+	s.subscription = s.pricesService.SubscribeTopPricesUpdate().
+		WatchEvents(ctx, func(updates []events.Event) {
+			s.onPricesUpdated(ctx, updates)
+		}, false)
+
+	return nil
+}
+
+// Stop implements core.Interface
+func (s *Service) Stop() {
+	if s.subscription != nil {
+		s.subscription.Cancel()
+		s.subscription = nil
+	}
+
+	if s.cancel != nil {
+		s.cancel()
+		s.sender.Wait()
+	}
+}
+
+// Rules returns configured rules sorted by name
+func (s *Service) Rules() []Rule {
+	s.rules.Lock()
+	defer s.rules.Unlock()
+
+	rules := make([]Rule, 0, len(s.rules.byName))
+	for _, rule := range s.rules.byName {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name < rules[j].Name
+	})
+
+	return rules
+}
+
+// AddRule adds a new rule, rules added at runtime are not persisted
+func (s *Service) AddRule(rule Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	if rule.Webhook != "" {
+		if _, ok := s.findWebhook(rule.Webhook); !ok {
+			return fmt.Errorf("rule '%s': unknown webhook '%s'", rule.Name, rule.Webhook)
+		}
+	}
+
+	s.rules.Lock()
+	defer s.rules.Unlock()
+
+	if _, exists := s.rules.byName[rule.Name]; exists {
+		return fmt.Errorf("rule '%s' already exists", rule.Name)
+	}
+	s.rules.byName[rule.Name] = rule
+	s.rules.states[rule.Name] = &ruleState{}
+
+	return nil
+}
+
+// RemoveRule removes rule by name, returns false if the rule does not exist
+func (s *Service) RemoveRule(name string) bool {
+	s.rules.Lock()
+	defer s.rules.Unlock()
+
+	if _, exists := s.rules.byName[name]; !exists {
+		return false
+	}
+	delete(s.rules.byName, name)
+	delete(s.rules.states, name)
+
+	return true
+}
+
+// Healthy implements health check, alerts do not affect service health
+func (s *Service) Healthy() bool {
+	return true
+}
+
+// onPricesUpdated evaluates rules of updated tokens, prices restored from snapshot are not evaluated
+func (s *Service) onPricesUpdated(ctx context.Context, updates []events.Event) {
+	updates = slices.DeleteFunc(updates, func(event events.Event) bool { return event.Restored })
+	if len(updates) == 0 {
+		return
+	}
+
+	changedIds, full := events.ChangedIDs(updates)
+	changed := make(map[string]bool, len(changedIds))
+	for _, id := range changedIds {
+		changed[id] = true
+	}
+
+	// Group token IDs of affected rules by currency
+	idsByCurrency := make(map[string][]string)
+	for _, rule := range s.Rules() {
+		if full || changed[rule.TokenID] {
+			currency := rule.GetCurrency()
+			idsByCurrency[currency] = append(idsByCurrency[currency], rule.TokenID)
+		}
+	}
+
+	for currency, ids := range idsByCurrency {
+		// Rules are evaluated against cached prices, tokens outside tiers don't spend on-demand budget
+		prices, _, err := s.pricesService.SimplePrices(ctx, interfaces.PriceParams{
+			IDs:        ids,
+			Currencies: []string{currency},
+			CacheOnly:  true,
+		})
+		if err != nil {
+			log.Printf("Alerts: failed to get prices: %v", err)
+			continue
+		}
+		s.evaluate(prices, currency, time.Now())
+	}
+}
+
+// evaluate evaluates rules in currency against prices and sends fired alerts
+func (s *Service) evaluate(prices interfaces.SimplePriceResponse, currency string, now time.Time) {
+	var fired []*Alert
+	var webhooks []string
+
+	s.rules.Lock()
+	for name, rule := range s.rules.byName {
+		if rule.GetCurrency() != currency {
+			continue
+		}
+		tokenData, ok := prices[rule.TokenID].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		price, ok := tokenData[currency].(float64)
+		if !ok {
+			continue
+		}
+
+		if alert := s.rules.states[name].evaluate(rule, price, now); alert != nil {
+			fired = append(fired, alert)
+			webhooks = append(webhooks, rule.Webhook)
+		}
+	}
+	s.rules.Unlock()
+
+	for i, alert := range fired {
+		log.Printf("Alerts: rule '%s' fired for %s at %f %s", alert.Rule, alert.TokenID, alert.Price, alert.Currency)
+		metrics.RecordAlertFired(s.metricsRuleLabel(alert.Rule))
+		s.send(*alert, webhooks[i])
+	}
+}
+
+// metricsRuleLabel returns the rule name for configured rules and "dynamic" for rules added at runtime
+func (s *Service) metricsRuleLabel(name string) string {
+	if s.configuredRules[name] {
+		return name
+	}
+	return "dynamic"
+}
+
+// send delivers alert to the given webhook or to all webhooks if name is empty
+func (s *Service) send(alert Alert, webhookName string) {
+	if webhookName != "" {
+		if webhook, ok := s.findWebhook(webhookName); ok {
+			s.sender.Send(webhook, alert)
+		}
+		return
+	}
+
+	for _, webhook := range s.config.Webhooks {
+		s.sender.Send(webhook, alert)
+	}
+}
+
+// findWebhook returns webhook by name
+func (s *Service) findWebhook(name string) (Webhook, bool) {
+	for _, webhook := range s.config.Webhooks {
+		if webhook.Name == name {
+			return webhook, true
+		}
+	}
+	return Webhook{}, false
+}
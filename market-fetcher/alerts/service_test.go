@@ -0,0 +1,109 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/status-im/market-proxy/events"
+	"github.com/status-im/market-proxy/interfaces"
+	mock_interfaces "github.com/status-im/market-proxy/interfaces/mocks"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	valid := Config{
+		Webhooks: []Webhook{{Name: "ops", URL: "http://localhost"}},
+		Rules:    []Rule{{Name: "btc", TokenID: "bitcoin", Type: RuleTypeAbove, Threshold: 1, Webhook: "ops"}},
+	}
+	assert.NoError(t, valid.Validate())
+
+	unknownWebhook := valid
+	unknownWebhook.Rules = []Rule{{Name: "btc", TokenID: "bitcoin", Type: RuleTypeAbove, Threshold: 1, Webhook: "dev"}}
+	assert.Error(t, unknownWebhook.Validate())
+
+	noWindow := valid
+	noWindow.Rules = []Rule{{Name: "btc", TokenID: "bitcoin", Type: RuleTypeChange, Threshold: 5}}
+	assert.Error(t, noWindow.Validate())
+
+	unknownType := valid
+	unknownType.Rules = []Rule{{Name: "btc", TokenID: "bitcoin", Type: "sideways", Threshold: 5}}
+	assert.Error(t, unknownType.Validate())
+}
+
+func TestService_AddRemoveRules(t *testing.T) {
+	service := NewService(Config{Webhooks: []Webhook{{Name: "ops", URL: "http://localhost"}}}, nil)
+
+	require.NoError(t, service.AddRule(Rule{Name: "b", TokenID: "bitcoin", Type: RuleTypeAbove, Threshold: 1}))
+	require.NoError(t, service.AddRule(Rule{Name: "a", TokenID: "ethereum", Type: RuleTypeBelow, Threshold: 1, Webhook: "ops"}))
+	assert.Error(t, service.AddRule(Rule{Name: "a", TokenID: "ethereum", Type: RuleTypeBelow, Threshold: 1}))
+	assert.Error(t, service.AddRule(Rule{Name: "c", TokenID: "ethereum", Type: RuleTypeBelow, Threshold: 1, Webhook: "dev"}))
+
+	rules := service.Rules()
+	require.Len(t, rules, 2)
+	assert.Equal(t, "a", rules[0].Name)
+
+	assert.True(t, service.RemoveRule("a"))
+	assert.False(t, service.RemoveRule("a"))
+	assert.Len(t, service.Rules(), 1)
+}
+
+func TestService_MetricsRuleLabel(t *testing.T) {
+	service := NewService(Config{Rules: []Rule{{Name: "btc", TokenID: "bitcoin", Type: RuleTypeAbove, Threshold: 1}}}, nil)
+	require.NoError(t, service.AddRule(Rule{Name: "eth", TokenID: "ethereum", Type: RuleTypeAbove, Threshold: 1}))
+
+	assert.Equal(t, "btc", service.metricsRuleLabel("btc"))
+	assert.Equal(t, "dynamic", service.metricsRuleLabel("eth"))
+}
+
+func TestService_FiresAlertOnPricesUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	received := make(chan Alert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert Alert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		received <- alert
+	}))
+	defer server.Close()
+
+	subscriptionManager := events.NewSubscriptionManager()
+	pricesService := mock_interfaces.NewMockIPricesService(ctrl)
+	pricesService.EXPECT().SubscribeTopPricesUpdate().Return(subscriptionManager.Subscribe())
+	pricesService.EXPECT().SimplePrices(gomock.Any(), interfaces.PriceParams{
+		IDs:        []string{"bitcoin"},
+		Currencies: []string{"usd"},
+		CacheOnly:  true,
+	}).Return(interfaces.SimplePriceResponse{
+		"bitcoin": map[string]interface{}{"usd": 100500.0},
+	}, interfaces.CacheStatusFull, nil)
+
+	service := NewService(Config{
+		Webhooks: []Webhook{{Name: "ops", URL: server.URL}},
+		Rules: []Rule{
+			{Name: "btc-100k", TokenID: "bitcoin", Type: RuleTypeAbove, Threshold: 100000},
+			{Name: "eth-low", TokenID: "ethereum", Type: RuleTypeBelow, Threshold: 2000},
+		},
+	}, pricesService)
+	require.NoError(t, service.Start(context.Background()))
+	defer service.Stop()
+
+	// Prices restored from snapshot are not evaluated, only rules of updated IDs are
+	subscriptionManager.Publish(context.Background(), events.Event{Tier: "top-1000", IDs: []string{"bitcoin"}, Restored: true})
+	subscriptionManager.Publish(context.Background(), events.Event{Tier: "top-1000", IDs: []string{"bitcoin", "solana"}})
+
+	select {
+	case alert := <-received:
+		assert.Equal(t, "btc-100k", alert.Rule)
+		assert.Equal(t, 100500.0, alert.Price)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for alert")
+	}
+}
@@ -0,0 +1,189 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/status-im/market-proxy/metrics"
+)
+
+const (
+	// SignatureHeader carries HMAC-SHA256 signature of "<timestamp>.<body>"
+	SignatureHeader = "X-Signature-256"
+
+	// TimestampHeader carries the signing time in unix seconds, receivers should reject
+	// requests with a timestamp too far from their clock to prevent replays
+	TimestampHeader = "X-Signature-Timestamp"
+
+	// deliveryQueueSize limits alerts waiting for delivery to a single webhook
+	deliveryQueueSize = 100
+)
+
+// delivery is an alert to be posted to a webhook
+type delivery struct {
+	webhook Webhook
+	body    []byte
+}
+
+// WebhookSender delivers alerts to webhooks in background with retries.
+// Each webhook has its own queue and worker, so a slow webhook does not delay the others.
+type WebhookSender struct {
+	client      *http.Client
+	maxAttempts int
+	retryDelay  time.Duration
+	wg          sync.WaitGroup
+
+	// Delivery queues by webhook name, workers are started lazily once the sender is started
+	queues struct {
+		sync.Mutex
+		ctx    context.Context
+		byName map[string]chan delivery
+	}
+}
+
+// NewWebhookSender creates a new webhook sender
+func NewWebhookSender(config Config) *WebhookSender {
+	sender := &WebhookSender{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		maxAttempts: config.GetMaxAttempts(),
+		retryDelay:  config.GetRetryDelay(),
+	}
+	sender.queues.byName = make(map[string]chan delivery)
+	return sender
+}
+
+// Start starts delivering queued alerts until ctx is done
+func (s *WebhookSender) Start(ctx context.Context) {
+	s.queues.Lock()
+	defer s.queues.Unlock()
+
+	s.queues.ctx = ctx
+	for _, queue := range s.queues.byName {
+		s.startWorker(ctx, queue)
+	}
+}
+
+// Wait waits for the delivery goroutines to finish
+func (s *WebhookSender) Wait() {
+	s.wg.Wait()
+}
+
+// Send queues alert for delivery to webhook, alerts are dropped if the queue is full
+func (s *WebhookSender) Send(webhook Webhook, alert Alert) {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		log.Printf("Alerts: failed to encode alert '%s': %v", alert.Rule, err)
+		return
+	}
+
+	select {
+	case s.queueFor(webhook.Name) <- delivery{webhook: webhook, body: body}:
+	default:
+		log.Printf("Alerts: delivery queue of webhook '%s' is full, dropping alert '%s'", webhook.Name, alert.Rule)
+		metrics.RecordAlertDelivery("dropped")
+	}
+}
+
+// queueFor returns the delivery queue of webhook, creating it and its worker on first use
+func (s *WebhookSender) queueFor(name string) chan delivery {
+	s.queues.Lock()
+	defer s.queues.Unlock()
+
+	queue, ok := s.queues.byName[name]
+	if !ok {
+		queue = make(chan delivery, deliveryQueueSize)
+		s.queues.byName[name] = queue
+		if s.queues.ctx != nil {
+			s.startWorker(s.queues.ctx, queue)
+		}
+	}
+	return queue
+}
+
+// startWorker delivers alerts from queue one by one until ctx is done
+func (s *WebhookSender) startWorker(ctx context.Context, queue chan delivery) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case d := <-queue:
+				s.deliver(ctx, d)
+			}
+		}
+	}()
+}
+
+// deliver posts alert to webhook, retrying with exponential backoff
+func (s *WebhookSender) deliver(ctx context.Context, d delivery) {
+	delay := s.retryDelay
+	for attempt := 1; attempt <= s.maxAttempts; attempt++ {
+		err := s.post(ctx, d)
+		if err == nil {
+			metrics.RecordAlertDelivery("success")
+			return
+		}
+
+		log.Printf("Alerts: delivery to webhook '%s' failed (attempt %d/%d): %v", d.webhook.Name, attempt, s.maxAttempts, err)
+		if attempt == s.maxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	metrics.RecordAlertDelivery("failed")
+}
+
+// post sends a single delivery request
+func (s *WebhookSender) post(ctx context.Context, d delivery) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook.URL, bytes.NewReader(d.body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if d.webhook.Secret != "" {
+		// Timestamp is taken per attempt, so retries are not rejected by the receiver tolerance window
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request.Header.Set(TimestampHeader, timestamp)
+		request.Header.Set(SignatureHeader, sign(d.webhook.Secret, timestamp, d.body))
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// sign returns HMAC-SHA256 signature of "<timestamp>.<body>" in "sha256=<hex>" format
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
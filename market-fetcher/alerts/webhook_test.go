@@ -0,0 +1,108 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSender_DeliversSignedAlertWithRetries(t *testing.T) {
+	var attempts atomic.Int32
+	received := make(chan Alert, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		timestamp := r.Header.Get(TimestampHeader)
+		assert.NotEmpty(t, timestamp)
+		assert.Equal(t, sign("secret", timestamp, body), r.Header.Get(SignatureHeader))
+
+		var alert Alert
+		require.NoError(t, json.Unmarshal(body, &alert))
+		received <- alert
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sender := NewWebhookSender(Config{RetryDelay: time.Millisecond})
+	sender.Start(ctx)
+	sender.Send(Webhook{Name: "ops", URL: server.URL, Secret: "secret"}, Alert{Rule: "btc-100k", Price: 100500})
+
+	select {
+	case alert := <-received:
+		assert.Equal(t, "btc-100k", alert.Rule)
+		assert.Equal(t, int32(3), attempts.Load())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for alert delivery")
+	}
+}
+
+func TestWebhookSender_SlowWebhookDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	received := make(chan struct{}, 1)
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+	}))
+	defer fast.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sender := NewWebhookSender(Config{RetryDelay: time.Millisecond})
+	sender.Start(ctx)
+	sender.Send(Webhook{Name: "slow", URL: slow.URL}, Alert{Rule: "btc-100k"})
+	sender.Send(Webhook{Name: "fast", URL: fast.URL}, Alert{Rule: "btc-100k"})
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("fast webhook was blocked by the slow one")
+	}
+}
+
+func TestWebhookSender_QueuesBeforeStart(t *testing.T) {
+	received := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(Config{})
+	sender.Send(Webhook{Name: "ops", URL: server.URL}, Alert{Rule: "btc-100k"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sender.Start(ctx)
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert queued before start was not delivered")
+	}
+}
+
+func TestSign(t *testing.T) {
+	// Reference value: echo -n '1700000000.body' | openssl dgst -sha256 -hmac 'secret'
+	assert.Equal(t, "sha256=42ac6f0448c1d9c3e1e82b9726248f58fef84afffcbad5188246e96070e0ea46", sign("secret", "1700000000", []byte("body")))
+}
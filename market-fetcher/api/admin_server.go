@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/status-im/market-proxy/alerts"
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// IAdminCache provides cache inspection and eviction for the admin API
+type IAdminCache interface {
+	GetItems(keys []string) (map[string]cache.Item, []string, error)
+	Delete(keys []string)
+}
+
+// IAlertsAdmin provides runtime management of price alert rules for the admin API
+type IAlertsAdmin interface {
+	Rules() []alerts.Rule
+	AddRule(rule alerts.Rule) error
+	RemoveRule(name string) bool
+}
+
+// AdminServer serves the admin API on a separate port, all requests require the admin bearer token
+type AdminServer struct {
+	config   config.AdminConfig
+	cache    IAdminCache
+	services map[string]interfaces.ITiersAdmin
+	alerts   IAlertsAdmin
+	server   *http.Server
+}
+
+// NewAdminServer creates an admin server, it is not started if no token is configured
+func NewAdminServer(cfg config.AdminConfig, cacheService IAdminCache) *AdminServer {
+	return &AdminServer{
+		config:   cfg,
+		cache:    cacheService,
+		services: make(map[string]interfaces.ITiersAdmin),
+	}
+}
+
+// RegisterTiersService makes tiers of the service available in the admin API under the given name
+func (s *AdminServer) RegisterTiersService(name string, service interfaces.ITiersAdmin) {
+	s.services[name] = service
+}
+
+// SetAlertsService makes price alert rules manageable through the admin API
+func (s *AdminServer) SetAlertsService(alertsService IAlertsAdmin) {
+	s.alerts = alertsService
+}
+
+// router creates the admin API router
+func (s *AdminServer) router() *mux.Router {
+	router := mux.NewRouter()
+	router.Use(s.authenticate)
+
+	router.HandleFunc("/api/v1/admin/services", s.handleListServices).Methods("GET")
+	router.HandleFunc("/api/v1/admin/services/{service}/tiers", s.handleServiceTiers).Methods("GET")
+	router.HandleFunc("/api/v1/admin/services/{service}/refresh", s.handleRefreshService).Methods("POST")
+	router.HandleFunc("/api/v1/admin/services/{service}/tiers/{tier}/refresh", s.handleRefreshTier).Methods("POST")
+	router.HandleFunc("/api/v1/admin/services/{service}/ids/{id}/refresh", s.handleRefreshID).Methods("POST")
+
+	router.HandleFunc("/api/v1/admin/cache", s.handleGetCacheItems).Methods("GET")
+	router.HandleFunc("/api/v1/admin/cache", s.handleDeleteCacheItems).Methods("DELETE")
+
+	router.HandleFunc("/api/v1/admin/api_keys", s.handleAPIKeys).Methods("GET")
+
+	if s.alerts != nil {
+		router.HandleFunc("/api/v1/admin/alerts/rules", s.handleListAlertRules).Methods("GET")
+		router.HandleFunc("/api/v1/admin/alerts/rules", s.handleAddAlertRule).Methods("POST")
+		router.HandleFunc("/api/v1/admin/alerts/rules/{name}", s.handleRemoveAlertRule).Methods("DELETE")
+	}
+
+	return router
+}
+
+// authenticate allows requests with a valid "Authorization: Bearer <token>" header only
+func (s *AdminServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if !s.config.Enabled() || !strings.HasPrefix(header, "Bearer ") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, "Bearer ")), []byte(s.config.Token)) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Start implements core.Interface
+func (s *AdminServer) Start(ctx context.Context) error {
+	if !s.config.Enabled() {
+		log.Printf("Admin server: token is not configured, admin API disabled")
+		return nil
+	}
+
+	s.server = &http.Server{
+		Addr:    s.config.Addr(),
+		Handler: s.router(),
+	}
+
+	log.Printf("Admin server starting at http://%s", s.config.Addr())
+
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("Admin server error: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop implements core.Interface
+func (s *AdminServer) Stop() {
+	if s.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.server.Shutdown(ctx); err != nil {
+			log.Printf("Error shutting down admin server: %v", err)
+		}
+	}
+}
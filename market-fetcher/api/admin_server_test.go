@@ -0,0 +1,228 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/alerts"
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+type fakeTiersService struct {
+	statuses     []interfaces.TierStatus
+	refreshedAll bool
+	refreshedIDs []string
+	updating     map[string]bool
+	notStarted   bool
+}
+
+func (f *fakeTiersService) TierStatuses() []interfaces.TierStatus { return f.statuses }
+
+func (f *fakeTiersService) RefreshAll() error {
+	if f.notStarted {
+		return interfaces.ErrNotStarted
+	}
+	f.refreshedAll = true
+	return nil
+}
+
+func (f *fakeTiersService) RefreshTier(name string) error {
+	for _, status := range f.statuses {
+		if status.Name != name {
+			continue
+		}
+		if f.updating[name] {
+			return fmt.Errorf("%w: %s", interfaces.ErrTierUpdating, name)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s", interfaces.ErrUnknownTier, name)
+}
+
+func (f *fakeTiersService) RefreshID(id string) error {
+	f.refreshedIDs = append(f.refreshedIDs, id)
+	return nil
+}
+
+type fakeAdminCache struct {
+	items   map[string]cache.Item
+	deleted []string
+}
+
+func (f *fakeAdminCache) GetItems(keys []string) (map[string]cache.Item, []string, error) {
+	found := make(map[string]cache.Item)
+	var missing []string
+	for _, key := range keys {
+		if item, exists := f.items[key]; exists {
+			found[key] = item
+		} else {
+			missing = append(missing, key)
+		}
+	}
+	return found, missing, nil
+}
+
+func (f *fakeAdminCache) Delete(keys []string) { f.deleted = append(f.deleted, keys...) }
+
+func newTestAdminServer() (*AdminServer, *fakeTiersService, *fakeAdminCache) {
+	tiers := &fakeTiersService{
+		statuses: []interfaces.TierStatus{{Name: "top-1000", ItemCount: 1000}, {Name: "top-5000"}},
+		updating: map[string]bool{"top-5000": true},
+	}
+	cacheService := &fakeAdminCache{items: map[string]cache.Item{
+		"price:bitcoin": {Data: []byte(`{"usd":1}`), StoredAt: time.Now().Add(-time.Minute)},
+		"raw":           {Data: []byte("not json"), Stale: true},
+	}}
+
+	server := NewAdminServer(config.AdminConfig{Token: "secret"}, cacheService)
+	server.RegisterTiersService("coingecko_prices", tiers)
+	return server, tiers, cacheService
+}
+
+func adminRequest(server *AdminServer, method, path, token string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	server.router().ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestAdminServer_Authentication(t *testing.T) {
+	server, _, _ := newTestAdminServer()
+
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(server, "GET", "/api/v1/admin/services", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(server, "GET", "/api/v1/admin/services", "wrong").Code)
+	assert.Equal(t, http.StatusOK, adminRequest(server, "GET", "/api/v1/admin/services", "secret").Code)
+
+	// Token without the Bearer scheme is rejected
+	request := httptest.NewRequest("GET", "/api/v1/admin/services", nil)
+	request.Header.Set("Authorization", "secret")
+	recorder := httptest.NewRecorder()
+	server.router().ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// Empty token disables the admin API
+	disabled := NewAdminServer(config.AdminConfig{}, &fakeAdminCache{})
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(disabled, "GET", "/api/v1/admin/services", "").Code)
+}
+
+func TestAdminServer_Tiers(t *testing.T) {
+	server, tiers, _ := newTestAdminServer()
+
+	recorder := adminRequest(server, "GET", "/api/v1/admin/services", "secret")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var services map[string][]interfaces.TierStatus
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &services))
+	require.Len(t, services["coingecko_prices"], 2)
+	assert.Equal(t, 1000, services["coingecko_prices"][0].ItemCount)
+
+	assert.Equal(t, http.StatusNotFound, adminRequest(server, "GET", "/api/v1/admin/services/unknown/tiers", "secret").Code)
+
+	assert.Equal(t, http.StatusAccepted, adminRequest(server, "POST", "/api/v1/admin/services/coingecko_prices/tiers/top-1000/refresh", "secret").Code)
+	assert.Equal(t, http.StatusConflict, adminRequest(server, "POST", "/api/v1/admin/services/coingecko_prices/tiers/top-5000/refresh", "secret").Code)
+	assert.Equal(t, http.StatusNotFound, adminRequest(server, "POST", "/api/v1/admin/services/coingecko_prices/tiers/other/refresh", "secret").Code)
+
+	assert.Equal(t, http.StatusAccepted, adminRequest(server, "POST", "/api/v1/admin/services/coingecko_prices/refresh", "secret").Code)
+	assert.True(t, tiers.refreshedAll)
+
+	assert.Equal(t, http.StatusAccepted, adminRequest(server, "POST", "/api/v1/admin/services/coingecko_prices/ids/bitcoin/refresh", "secret").Code)
+	assert.Equal(t, []string{"bitcoin"}, tiers.refreshedIDs)
+
+	// Refreshes are rejected until the service is started
+	tiers.notStarted = true
+	assert.Equal(t, http.StatusServiceUnavailable, adminRequest(server, "POST", "/api/v1/admin/services/coingecko_prices/refresh", "secret").Code)
+}
+
+func TestAdminServer_Cache(t *testing.T) {
+	server, _, cacheService := newTestAdminServer()
+
+	assert.Equal(t, http.StatusBadRequest, adminRequest(server, "GET", "/api/v1/admin/cache", "secret").Code)
+
+	recorder := adminRequest(server, "GET", "/api/v1/admin/cache?key=price:bitcoin&key=raw&key=missing", "secret")
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Items   map[string]adminCacheItem `json:"items"`
+		Missing []string                  `json:"missing"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.JSONEq(t, `{"usd":1}`, string(response.Items["price:bitcoin"].Data))
+	assert.GreaterOrEqual(t, response.Items["price:bitcoin"].AgeSeconds, int64(60))
+	assert.JSONEq(t, `"not json"`, string(response.Items["raw"].Data))
+	assert.True(t, response.Items["raw"].Stale)
+	assert.Equal(t, []string{"missing"}, response.Missing)
+
+	assert.Equal(t, http.StatusNoContent, adminRequest(server, "DELETE", "/api/v1/admin/cache?key=price:bitcoin", "secret").Code)
+	assert.Equal(t, []string{"price:bitcoin"}, cacheService.deleted)
+}
+
+type fakeAlertsAdmin struct {
+	rules map[string]alerts.Rule
+}
+
+func (f *fakeAlertsAdmin) Rules() []alerts.Rule {
+	rules := make([]alerts.Rule, 0, len(f.rules))
+	for _, rule := range f.rules {
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func (f *fakeAlertsAdmin) AddRule(rule alerts.Rule) error {
+	if rule.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	f.rules[rule.Name] = rule
+	return nil
+}
+
+func (f *fakeAlertsAdmin) RemoveRule(name string) bool {
+	if _, exists := f.rules[name]; !exists {
+		return false
+	}
+	delete(f.rules, name)
+	return true
+}
+
+func TestAdminServer_AlertRules(t *testing.T) {
+	server, _, _ := newTestAdminServer()
+
+	// Alert routes are not registered without alerts service
+	assert.Equal(t, http.StatusNotFound, adminRequest(server, "GET", "/api/v1/admin/alerts/rules", "secret").Code)
+
+	alertsService := &fakeAlertsAdmin{rules: make(map[string]alerts.Rule)}
+	server.SetAlertsService(alertsService)
+
+	assert.Equal(t, http.StatusUnauthorized, adminRequest(server, "GET", "/api/v1/admin/alerts/rules", "").Code)
+
+	request := httptest.NewRequest("POST", "/api/v1/admin/alerts/rules",
+		strings.NewReader(`{"name":"btc","token_id":"Bitcoin","type":"above","threshold":100000,"cooldown":"1h"}`))
+	request.Header.Set("Authorization", "Bearer secret")
+	recorder := httptest.NewRecorder()
+	server.router().ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "bitcoin", alertsService.rules["btc"].TokenID)
+	assert.Equal(t, time.Hour, alertsService.rules["btc"].Cooldown)
+
+	recorder = adminRequest(server, "GET", "/api/v1/admin/alerts/rules", "secret")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var rules []alertRuleJSON
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rules))
+	require.Len(t, rules, 1)
+	assert.Equal(t, "btc", rules[0].Name)
+
+	assert.Equal(t, http.StatusNoContent, adminRequest(server, "DELETE", "/api/v1/admin/alerts/rules/btc", "secret").Code)
+	assert.Equal(t, http.StatusNotFound, adminRequest(server, "DELETE", "/api/v1/admin/alerts/rules/btc", "secret").Code)
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	cg "github.com/status-im/market-proxy/coingecko_common"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// adminCacheItem is a cached item as returned by the admin API
+type adminCacheItem struct {
+	Data       json.RawMessage `json:"data"`
+	StoredAt   *time.Time      `json:"stored_at,omitempty"`
+	AgeSeconds int64           `json:"age_seconds"`
+	Stale      bool            `json:"stale"`
+}
+
+// writeAdminJSON writes data as JSON with the given status code
+func writeAdminJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error writing admin response: %v", err)
+	}
+}
+
+// getService returns the service named in the request path or responds with 404
+func (s *AdminServer) getService(w http.ResponseWriter, r *http.Request) (interfaces.ITiersAdmin, bool) {
+	name := mux.Vars(r)["service"]
+	service, exists := s.services[name]
+	if !exists {
+		http.Error(w, "Unknown service: "+name, http.StatusNotFound)
+		return nil, false
+	}
+	return service, true
+}
+
+// handleListServices responds with tier statuses of all registered services
+func (s *AdminServer) handleListServices(w http.ResponseWriter, r *http.Request) {
+	response := make(map[string][]interfaces.TierStatus, len(s.services))
+	for name, service := range s.services {
+		response[name] = service.TierStatuses()
+	}
+	writeAdminJSON(w, http.StatusOK, response)
+}
+
+// handleServiceTiers responds with tier statuses of a service
+func (s *AdminServer) handleServiceTiers(w http.ResponseWriter, r *http.Request) {
+	service, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+	writeAdminJSON(w, http.StatusOK, service.TierStatuses())
+}
+
+// handleRefreshService starts an update of all tiers of a service
+func (s *AdminServer) handleRefreshService(w http.ResponseWriter, r *http.Request) {
+	service, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	writeRefreshResult(w, service.RefreshAll())
+}
+
+// handleRefreshTier starts an update of a single tier
+func (s *AdminServer) handleRefreshTier(w http.ResponseWriter, r *http.Request) {
+	service, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	writeRefreshResult(w, service.RefreshTier(mux.Vars(r)["tier"]))
+}
+
+// handleRefreshID starts a fetch of a single ID
+func (s *AdminServer) handleRefreshID(w http.ResponseWriter, r *http.Request) {
+	service, ok := s.getService(w, r)
+	if !ok {
+		return
+	}
+
+	writeRefreshResult(w, service.RefreshID(mux.Vars(r)["id"]))
+}
+
+// writeRefreshResult responds with 202 if a refresh was started or with the status matching its error
+func writeRefreshResult(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, interfaces.ErrUnknownTier):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, interfaces.ErrTierUpdating):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, interfaces.ErrNotStarted):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	default:
+		writeAdminJSON(w, http.StatusAccepted, map[string]string{"status": "refresh started"})
+	}
+}
+
+// handleGetCacheItems responds with cached items of keys passed in "key" query parameters
+func (s *AdminServer) handleGetCacheItems(w http.ResponseWriter, r *http.Request) {
+	keys := r.URL.Query()["key"]
+	if len(keys) == 0 {
+		http.Error(w, "Missing required parameter: key", http.StatusBadRequest)
+		return
+	}
+
+	items, missing, err := s.cache.GetItems(keys)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	response := struct {
+		Items   map[string]adminCacheItem `json:"items"`
+		Missing []string                  `json:"missing"`
+	}{
+		Items:   make(map[string]adminCacheItem, len(items)),
+		Missing: missing,
+	}
+	for key, item := range items {
+		cacheItem := adminCacheItem{
+			AgeSeconds: int64(item.Age(now).Seconds()),
+			Stale:      item.Stale,
+		}
+		if !item.StoredAt.IsZero() {
+			storedAt := item.StoredAt
+			cacheItem.StoredAt = &storedAt
+		}
+		// Non-JSON values are returned as JSON strings
+		if json.Valid(item.Data) {
+			cacheItem.Data = item.Data
+		} else {
+			cacheItem.Data, _ = json.Marshal(string(item.Data))
+		}
+		response.Items[key] = cacheItem
+	}
+
+	writeAdminJSON(w, http.StatusOK, response)
+}
+
+// handleDeleteCacheItems evicts keys passed in "key" query parameters from local and L2 caches
+func (s *AdminServer) handleDeleteCacheItems(w http.ResponseWriter, r *http.Request) {
+	keys := r.URL.Query()["key"]
+	if len(keys) == 0 {
+		http.Error(w, "Missing required parameter: key", http.StatusBadRequest)
+		return
+	}
+
+	s.cache.Delete(keys)
+	log.Printf("Admin server: evicted %d cache keys", len(keys))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAPIKeys responds with backoff state of configured CoinGecko API keys
+func (s *AdminServer) handleAPIKeys(w http.ResponseWriter, r *http.Request) {
+	writeAdminJSON(w, http.StatusOK, cg.GetAPIKeyStates())
+}
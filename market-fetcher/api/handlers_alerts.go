@@ -0,0 +1,110 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/status-im/market-proxy/alerts"
+)
+
+// alertRuleJSON is a JSON representation of alerts.Rule with durations as strings (e.g. "1h")
+type alertRuleJSON struct {
+	Name      string  `json:"name"`
+	TokenID   string  `json:"token_id"`
+	Currency  string  `json:"currency,omitempty"`
+	Type      string  `json:"type"`
+	Threshold float64 `json:"threshold"`
+	Window    string  `json:"window,omitempty"`
+	Cooldown  string  `json:"cooldown,omitempty"`
+	Webhook   string  `json:"webhook,omitempty"`
+}
+
+// handleListAlertRules responds with all alert rules
+func (s *AdminServer) handleListAlertRules(w http.ResponseWriter, r *http.Request) {
+	rules := s.alerts.Rules()
+
+	response := make([]alertRuleJSON, 0, len(rules))
+	for _, rule := range rules {
+		response = append(response, alertRuleToJSON(rule))
+	}
+
+	writeAdminJSON(w, http.StatusOK, response)
+}
+
+// handleAddAlertRule adds an alert rule from JSON body
+func (s *AdminServer) handleAddAlertRule(w http.ResponseWriter, r *http.Request) {
+	var request alertRuleJSON
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rule, err := alertRuleFromJSON(request)
+	if err != nil {
+		http.Error(w, "Invalid rule: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.alerts.AddRule(rule); err != nil {
+		http.Error(w, "Invalid rule: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeAdminJSON(w, http.StatusOK, alertRuleToJSON(rule))
+}
+
+// handleRemoveAlertRule removes an alert rule by name
+func (s *AdminServer) handleRemoveAlertRule(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+	if !s.alerts.RemoveRule(name) {
+		http.Error(w, "Rule not found: "+name, http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func alertRuleToJSON(rule alerts.Rule) alertRuleJSON {
+	result := alertRuleJSON{
+		Name:      rule.Name,
+		TokenID:   rule.TokenID,
+		Currency:  rule.GetCurrency(),
+		Type:      rule.Type,
+		Threshold: rule.Threshold,
+		Cooldown:  rule.GetCooldown().String(),
+		Webhook:   rule.Webhook,
+	}
+	if rule.Window > 0 {
+		result.Window = rule.Window.String()
+	}
+	return result
+}
+
+func alertRuleFromJSON(request alertRuleJSON) (alerts.Rule, error) {
+	rule := alerts.Rule{
+		Name:      request.Name,
+		TokenID:   strings.ToLower(request.TokenID),
+		Currency:  strings.ToLower(request.Currency),
+		Type:      request.Type,
+		Threshold: request.Threshold,
+		Webhook:   request.Webhook,
+	}
+
+	var err error
+	if request.Window != "" {
+		if rule.Window, err = time.ParseDuration(request.Window); err != nil {
+			return rule, err
+		}
+	}
+	if request.Cooldown != "" {
+		if rule.Cooldown, err = time.ParseDuration(request.Cooldown); err != nil {
+			return rule, err
+		}
+	}
+
+	return rule, nil
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/status-im/market-proxy/coingecko_assets_platforms"
 )
@@ -20,5 +21,20 @@ func (s *Server) handleAssetsPlatforms(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.sendJSONResponse(w, data)
+	s.setFreshnessHeaders(w, s.assetsPlatformsService.UpdatedAt(), s.getUpdateIntervals().platforms)
+	s.sendJSONResponse(w, r, data)
+}
+
+// resolvePlatform maps a numeric chain ID to CoinGecko platform ID, other values are returned as is
+func (s *Server) resolvePlatform(platform string) string {
+	chainID, err := strconv.ParseInt(platform, 10, 64)
+	if err != nil || s.assetsPlatformsService == nil {
+		return platform
+	}
+
+	if assetPlatform, ok := s.assetsPlatformsService.GetPlatformByChainID(chainID); ok {
+		return assetPlatform.ID
+	}
+
+	return platform
 }
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+)
+
+// categoryResponse is an entry of the categories list, Cached tells if /coins/markets serves the category from cache
+type categoryResponse struct {
+	CategoryID string `json:"category_id"`
+	Name       string `json:"name"`
+	Cached     bool   `json:"cached"`
+}
+
+// handleCoinsCategories responds with the list of CoinGecko coin categories
+func (s *Server) handleCoinsCategories(w http.ResponseWriter, r *http.Request) {
+	categories := s.categoriesService.Categories()
+	if len(categories) == 0 {
+		http.Error(w, "No categories data available", http.StatusServiceUnavailable)
+		return
+	}
+
+	cached := make(map[string]bool)
+	for _, category := range s.marketsService.CachedCategories() {
+		cached[category] = true
+	}
+
+	response := make([]categoryResponse, 0, len(categories))
+	for _, category := range categories {
+		response = append(response, categoryResponse{
+			CategoryID: category.CategoryID,
+			Name:       category.Name,
+			Cached:     cached[category.CategoryID],
+		})
+	}
+
+	s.sendJSONResponse(w, r, response)
+}
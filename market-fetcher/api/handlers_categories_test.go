@@ -0,0 +1,21 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/status-im/market-proxy/coingecko_categories"
+	"github.com/status-im/market-proxy/config"
+)
+
+func TestHandleCoinsCategories_NotLoaded(t *testing.T) {
+	server := &Server{categoriesService: coingecko_categories.NewService(&config.Config{APITokens: &config.APITokens{}})}
+
+	recorder := httptest.NewRecorder()
+	server.handleCoinsRoutes(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/coins/categories", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
@@ -1,14 +1,20 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/status-im/market-proxy/interfaces"
 
 	"github.com/status-im/market-proxy/coingecko_market_chart"
+	"github.com/status-im/market-proxy/coingecko_markets"
+	"github.com/status-im/market-proxy/coingecko_ohlc"
+	"github.com/status-im/market-proxy/fetcher_by_id"
 )
 
 // handleCoinsList responds with the list of tokens filtered by supported platforms
@@ -23,13 +29,13 @@ func (s *Server) handleCoinsList(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	tokens := s.tokensService.GetTokens()
-	if len(tokens) == 0 {
+	tokensResponse := s.tokensService.GetTokensSnapshot()
+	if tokensResponse == nil {
 		http.Error(w, "No token data available", http.StatusServiceUnavailable)
 		return
 	}
 
-	s.sendJSONResponse(w, tokens)
+	s.sendSnapshot(w, r, tokensResponse, s.getUpdateIntervals().coinsList)
 }
 
 // handleCoinsMarkets implements CoinGecko-compatible /api/v3/coins/markets endpoint
@@ -63,6 +69,16 @@ func (s *Server) handleCoinsMarkets(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if categoryParam := getParamLowercase(r, "category"); categoryParam != "" {
+		if !s.categoriesService.IsKnownCategory(categoryParam) {
+			http.Error(w, "Unknown category: "+categoryParam, http.StatusBadRequest)
+			return
+		}
+		// Only categories with configured tiers are served, others would always be empty
+		if cachedCategories := s.marketsService.CachedCategories(); !slices.Contains(cachedCategories, categoryParam) {
+			http.Error(w, fmt.Sprintf("Category is not cached: %s, cached categories: %s",
+				categoryParam, strings.Join(cachedCategories, ", ")), http.StatusUnprocessableEntity)
+			return
+		}
 		params.Category = categoryParam
 	}
 
@@ -76,31 +92,75 @@ func (s *Server) handleCoinsMarkets(w http.ResponseWriter, r *http.Request) {
 		params.PriceChangePercentage = splitParamLowercase(priceChangeParam)
 	}
 
-	data, cacheStatus, err := s.marketsService.Markets(params)
+	if minMarketCapParam := r.URL.Query().Get("min_market_cap"); minMarketCapParam != "" {
+		if minMarketCap, err := strconv.ParseFloat(minMarketCapParam, 64); err == nil && minMarketCap > 0 {
+			params.MinMarketCap = minMarketCap
+		}
+	}
+
+	if minVolumeParam := r.URL.Query().Get("min_volume"); minVolumeParam != "" {
+		if minVolume, err := strconv.ParseFloat(minVolumeParam, 64); err == nil && minVolume > 0 {
+			params.MinVolume = minVolume
+		}
+	}
+
+	data, meta, err := s.marketsService.MarketsWithMeta(params)
 	if err != nil {
+		if errors.Is(err, coingecko_markets.ErrInvalidOrder) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to fetch markets data: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	s.setCacheStatusHeader(w, cacheStatus.String())
-	s.sendJSONResponse(w, data)
+	updatedAt, updateInterval := s.marketsService.UpdatedAt(params)
+	s.setCacheStatusHeader(w, meta.CacheStatus.String())
+	s.setFreshnessHeaders(w, updatedAt, updateInterval)
+	s.setDerivedCurrenciesHeader(w, meta.DerivedCurrencies)
+	s.sendJSONResponse(w, r, data)
 }
 
 // handleSimplePrice implements CoinGecko-compatible /api/v3/simple/price endpoint
 func (s *Server) handleSimplePrice(w http.ResponseWriter, r *http.Request) {
+	params, err := parseSimplePriceParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, meta, err := s.pricesService.SimplePricesWithMeta(r.Context(), params)
+	if err != nil {
+		http.Error(w, "Failed to fetch prices: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.setCacheStatusHeader(w, meta.CacheStatus.String())
+	s.setAgeHeader(w, meta.Age)
+	s.setFreshnessHeaders(w, time.Now().Add(-meta.Age), s.getUpdateIntervals().prices)
+	s.setDerivedCurrenciesHeader(w, meta.DerivedCurrencies)
+	s.sendJSONResponse(w, r, response)
+}
+
+// parseSimplePriceParams parses CoinGecko-compatible /simple/price query parameters
+func parseSimplePriceParams(r *http.Request) (interfaces.PriceParams, error) {
 	params := interfaces.PriceParams{}
 
 	idsParam := getParamLowercase(r, "ids")
 	if idsParam == "" {
-		http.Error(w, "Parameter 'ids' is required", http.StatusBadRequest)
-		return
+		return params, fmt.Errorf("Parameter 'ids' is required")
 	}
 	params.IDs = splitParamLowercase(idsParam)
 
+	err := parsePriceOptions(r, &params)
+	return params, err
+}
+
+// parsePriceOptions parses currencies and include_* query parameters shared by price endpoints
+func parsePriceOptions(r *http.Request, params *interfaces.PriceParams) error {
 	currenciesParam := getParamLowercase(r, "vs_currencies")
 	if currenciesParam == "" {
-		http.Error(w, "Parameter 'vs_currencies' is required", http.StatusBadRequest)
-		return
+		return fmt.Errorf("Parameter 'vs_currencies' is required")
 	}
 	params.Currencies = splitParamLowercase(currenciesParam)
 
@@ -128,14 +188,7 @@ func (s *Server) handleSimplePrice(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	response, cacheStatus, err := s.pricesService.SimplePrices(r.Context(), params)
-	if err != nil {
-		http.Error(w, "Failed to fetch prices: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	s.setCacheStatusHeader(w, cacheStatus.String())
-	s.sendJSONResponse(w, response)
+	return nil
 }
 
 // handleMarketChart implements CoinGecko-compatible /api/v3/coins/{id}/market_chart endpoint
@@ -173,7 +226,37 @@ func (s *Server) handleMarketChart(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Cache-Control", "public, max-age=60")
-	s.sendJSONResponse(w, data)
+	s.sendJSONResponse(w, r, data)
+}
+
+// handleOHLC implements CoinGecko-compatible /api/v3/coins/{id}/ohlc endpoint
+func (s *Server) handleOHLC(w http.ResponseWriter, r *http.Request) {
+	// Path format: /api/v1/coins/{id}/ohlc
+	pathSegments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(pathSegments) < 4 || pathSegments[3] == "" {
+		http.Error(w, "Missing coin ID in path", http.StatusBadRequest)
+		return
+	}
+
+	params := coingecko_ohlc.OHLCParams{
+		ID:       strings.ToLower(pathSegments[3]),
+		Currency: getParamLowercase(r, "vs_currency"),
+		Days:     getParamLowercase(r, "days"),
+		Interval: getParamLowercase(r, "interval"),
+	}
+
+	data, err := s.ohlcService.OHLC(params)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid parameters") {
+			http.Error(w, fmt.Sprintf("Bad request: %v", err), http.StatusBadRequest)
+		} else {
+			http.Error(w, fmt.Sprintf("Error fetching OHLC: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	s.sendJSONResponse(w, r, data)
 }
 
 // handleCoinsID implements CoinGecko-compatible /api/v3/coins/{id} endpoint
@@ -185,23 +268,30 @@ func (s *Server) handleCoinsID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	coinID := strings.ToLower(pathSegments[3])
+	s.sendCoin(w, r, strings.ToLower(pathSegments[3]))
+}
 
+// sendCoin responds with the coin document by coin ID
+func (s *Server) sendCoin(w http.ResponseWriter, r *http.Request, coinID string) {
 	data, cacheStatus, err := s.coinsService.GetCoin(coinID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		switch {
+		case errors.Is(err, fetcher_by_id.ErrUnknownID):
 			http.Error(w, fmt.Sprintf("Coin not found: %s", coinID), http.StatusNotFound)
-		} else {
+		case errors.Is(err, fetcher_by_id.ErrNotCached):
+			http.Error(w, fmt.Sprintf("Coin data is not cached yet: %s", coinID), http.StatusServiceUnavailable)
+		case errors.Is(err, fetcher_by_id.ErrUpstreamFetch):
+			http.Error(w, fmt.Sprintf("Error fetching coin data from upstream: %s", coinID), http.StatusBadGateway)
+		default:
 			http.Error(w, fmt.Sprintf("Error fetching coin data: %v", err), http.StatusInternalServerError)
 		}
 		return
 	}
 
+	updatedAt, updateInterval := s.coinsService.CoinUpdatedAt(coinID)
 	s.setCacheStatusHeader(w, cacheStatus.String())
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(data)
+	s.setFreshnessHeaders(w, updatedAt, updateInterval)
+	s.sendResponseBytes(w, r, "application/json", data)
 }
 
 // handleCoinsRoutes routes different /api/v1/coins/* endpoints to appropriate handlers
@@ -216,12 +306,25 @@ func (s *Server) handleCoinsRoutes(w http.ResponseWriter, r *http.Request) {
 		case "markets":
 			s.handleCoinsMarkets(w, r)
 			return
+		case "categories":
+			s.handleCoinsCategories(w, r)
+			return
 		default:
 			// Check if this is a market_chart request: /api/v1/coins/{id}/market_chart
 			if len(pathSegments) >= 5 && pathSegments[4] == "market_chart" {
 				s.handleMarketChart(w, r)
 				return
 			}
+			// Check if this is an OHLC request: /api/v1/coins/{id}/ohlc
+			if len(pathSegments) >= 5 && pathSegments[4] == "ohlc" {
+				s.handleOHLC(w, r)
+				return
+			}
+			// Check if this is a contract request: /api/v1/coins/{platform}/contract/{address}
+			if len(pathSegments) == 6 && pathSegments[4] == "contract" {
+				s.handleCoinByContract(w, r)
+				return
+			}
 			// Otherwise, treat it as a coin ID request: /api/v1/coins/{id}
 			s.handleCoinsID(w, r)
 			return
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// maxBatchCoinIds is the maximum number of coin IDs in one batch request
+	maxBatchCoinIds = 250
+
+	// maxBatchCoinsBodySize limits the body of POST batch request, enough for maxBatchCoinIds long IDs
+	maxBatchCoinsBodySize = 64 << 10
+)
+
+// Per-ID statuses of the batch coins response
+const (
+	batchCoinStatusOK        = "ok"         // coin document is returned
+	batchCoinStatusNotCached = "not_cached" // coin is known but not cached yet
+	batchCoinStatusUnknown   = "unknown"    // coin is not present in coins list and markets
+)
+
+// batchCoinResult is a coin document with its status in the batch coins response
+type batchCoinResult struct {
+	Status string          `json:"status"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+// batchCoinsRequest is a body of POST /api/v1/coins request
+type batchCoinsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// handleCoinsBatch responds with cached coin documents for multiple IDs:
+// GET /api/v1/coins?ids=bitcoin,ethereum or POST /api/v1/coins with {"ids": [...]} body
+func (s *Server) handleCoinsBatch(w http.ResponseWriter, r *http.Request) {
+	ids, err := parseBatchCoinIds(w, r)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, fmt.Sprintf("Request body is too large, maximum is %d bytes", maxBatchCoinsBodySize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, missing, cacheStatus := s.coinsService.GetMultipleCoins(ids)
+
+	unknown := make(map[string]bool)
+	for _, id := range s.coinsService.UnknownCoins(missing) {
+		unknown[id] = true
+	}
+
+	response := make(map[string]batchCoinResult, len(ids))
+	for _, id := range ids {
+		switch document, ok := data[id]; {
+		case ok:
+			response[id] = batchCoinResult{Status: batchCoinStatusOK, Data: document}
+		case unknown[id]:
+			response[id] = batchCoinResult{Status: batchCoinStatusUnknown}
+		default:
+			response[id] = batchCoinResult{Status: batchCoinStatusNotCached}
+		}
+	}
+
+	s.setCacheStatusHeader(w, cacheStatus.String())
+	s.sendJSONResponse(w, r, response)
+}
+
+// parseBatchCoinIds reads lowercase unique coin IDs from `ids` query parameter or JSON body of POST request
+func parseBatchCoinIds(w http.ResponseWriter, r *http.Request) ([]string, error) {
+	var rawIds []string
+	if r.Method == http.MethodPost {
+		var request batchCoinsRequest
+		body := http.MaxBytesReader(w, r.Body, maxBatchCoinsBodySize)
+		if err := json.NewDecoder(body).Decode(&request); err != nil {
+			return nil, fmt.Errorf("Invalid request body: %w", err)
+		}
+		rawIds = request.IDs
+	} else {
+		rawIds = splitParamLowercase(getParamLowercase(r, "ids"))
+	}
+
+	seen := make(map[string]bool, len(rawIds))
+	ids := make([]string, 0, len(rawIds))
+	for _, id := range rawIds {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("Parameter 'ids' is required")
+	}
+	if len(ids) > maxBatchCoinIds {
+		return nil, fmt.Errorf("Too many ids: %d, maximum is %d", len(ids), maxBatchCoinIds)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/coingecko_coins"
+	"github.com/status-im/market-proxy/config"
+	mock_interfaces "github.com/status-im/market-proxy/interfaces/mocks"
+)
+
+func TestParseBatchCoinIds(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/coins?ids=Bitcoin,ethereum,bitcoin,", nil)
+	ids, err := parseBatchCoinIds(httptest.NewRecorder(), request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bitcoin", "ethereum"}, ids)
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/coins", strings.NewReader(`{"ids": ["Solana", " bitcoin "]}`))
+	ids, err = parseBatchCoinIds(httptest.NewRecorder(), request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"solana", "bitcoin"}, ids)
+
+	_, err = parseBatchCoinIds(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/coins", nil))
+	assert.Error(t, err)
+
+	_, err = parseBatchCoinIds(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/coins", strings.NewReader(`not json`)))
+	assert.Error(t, err)
+
+	tooMany := make([]string, maxBatchCoinIds+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("coin-%d", i)
+	}
+	_, err = parseBatchCoinIds(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/coins?ids="+strings.Join(tooMany, ","), nil))
+	assert.Error(t, err)
+
+	tooManyBody, err := json.Marshal(batchCoinsRequest{IDs: tooMany})
+	require.NoError(t, err)
+	_, err = parseBatchCoinIds(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/coins", strings.NewReader(string(tooManyBody))))
+	assert.Error(t, err)
+}
+
+func TestHandleCoinsBatch_BodyTooLarge(t *testing.T) {
+	body := `{"ids": ["` + strings.Repeat("a", maxBatchCoinsBodySize) + `"]}`
+
+	recorder := httptest.NewRecorder()
+	server := &Server{}
+	server.handleCoinsBatch(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/coins", strings.NewReader(body)))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+}
+
+func TestHandleCoinsBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		CoingeckoCoins: config.FetcherByIdConfig{
+			Name:         "coins",
+			EndpointPath: "/api/v3/coins/{{id}}",
+			TTL:          time.Hour,
+		},
+	}
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	require.NoError(t, cacheService.Set(map[string][]byte{
+		cfg.CoingeckoCoins.BuildCacheKey("bitcoin"): []byte(`{"id":"bitcoin"}`),
+	}, time.Hour))
+
+	mockTokens := mock_interfaces.NewMockITokensService(ctrl)
+	mockTokens.EXPECT().GetTokenIds().Return([]string{"bitcoin", "ethereum"}).AnyTimes()
+
+	coinsService := coingecko_coins.NewService(cfg, nil, cacheService)
+	coinsService.SetTokensService(mockTokens)
+	server := &Server{coinsService: coinsService}
+
+	recorder := httptest.NewRecorder()
+	server.handleCoinsBatch(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/coins?ids=bitcoin,ethereum,not-a-coin", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "partial", recorder.Header().Get("Cache-Status"))
+
+	var response map[string]batchCoinResult
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, map[string]batchCoinResult{
+		"bitcoin":    {Status: batchCoinStatusOK, Data: json.RawMessage(`{"id":"bitcoin"}`)},
+		"ethereum":   {Status: batchCoinStatusNotCached},
+		"not-a-coin": {Status: batchCoinStatusUnknown},
+	}, response)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/status-im/market-proxy/coingecko_tokens"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// maxContractAddresses is the maximum number of contract addresses in one token price request
+const maxContractAddresses = 250
+
+// handleCoinByContract implements CoinGecko-compatible /api/v3/coins/{platform}/contract/{address} endpoint,
+// platform can also be given as chain ID
+func (s *Server) handleCoinByContract(w http.ResponseWriter, r *http.Request) {
+	// Path format: /api/v1/coins/{platform}/contract/{address}
+	pathSegments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	platform := s.resolvePlatform(strings.ToLower(pathSegments[3]))
+
+	address, err := coingecko_tokens.NormalizeAddress(pathSegments[5])
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid contract address: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	coinID, ok := s.tokensService.TokenIDByAddress(platform, address)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Coin not found: %s on %s", address, platform), http.StatusNotFound)
+		return
+	}
+
+	s.sendCoin(w, r, coinID)
+}
+
+// handleSimpleTokenPrice implements CoinGecko-compatible /api/v3/simple/token_price/{platform} endpoint.
+// Contract addresses are resolved to coin IDs and answered from cached prices,
+// unknown addresses are omitted from the response.
+func (s *Server) handleSimpleTokenPrice(w http.ResponseWriter, r *http.Request) {
+	platform := s.resolvePlatform(strings.ToLower(mux.Vars(r)["platform"]))
+
+	addressesParam := r.URL.Query().Get("contract_addresses")
+	if addressesParam == "" {
+		http.Error(w, "Parameter 'contract_addresses' is required", http.StatusBadRequest)
+		return
+	}
+
+	params := interfaces.PriceParams{}
+	if err := parsePriceOptions(r, &params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	addressesByID, err := s.resolveContractAddresses(platform, strings.Split(addressesParam, ","))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := make(interfaces.SimplePriceResponse)
+	if len(addressesByID) == 0 {
+		s.sendJSONResponse(w, r, response)
+		return
+	}
+
+	for coinID := range addressesByID {
+		params.IDs = append(params.IDs, coinID)
+	}
+
+	prices, meta, err := s.pricesService.SimplePricesWithMeta(r.Context(), params)
+	if err != nil {
+		http.Error(w, "Failed to fetch prices: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for coinID, tokenData := range prices {
+		for _, address := range addressesByID[coinID] {
+			response[address] = tokenData
+		}
+	}
+
+	s.setCacheStatusHeader(w, meta.CacheStatus.String())
+	s.setAgeHeader(w, meta.Age)
+	s.setFreshnessHeaders(w, time.Now().Add(-meta.Age), s.getUpdateIntervals().prices)
+	s.setDerivedCurrenciesHeader(w, meta.DerivedCurrencies)
+	s.sendJSONResponse(w, r, response)
+}
+
+// resolveContractAddresses maps coin IDs to requested normalized addresses, unknown addresses are skipped.
+// Returns an error if an address is invalid or there are more than maxContractAddresses addresses.
+func (s *Server) resolveContractAddresses(platform string, addresses []string) (map[string][]string, error) {
+	addressesByID := make(map[string][]string)
+	seen := make(map[string]bool)
+
+	count := 0
+	for _, rawAddress := range addresses {
+		if strings.TrimSpace(rawAddress) == "" {
+			continue
+		}
+		count++
+		if count > maxContractAddresses {
+			return nil, fmt.Errorf("Too many contract addresses, maximum is %d", maxContractAddresses)
+		}
+
+		address, err := coingecko_tokens.NormalizeAddress(rawAddress)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid contract address %s: %v", strings.TrimSpace(rawAddress), err)
+		}
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+
+		if coinID, ok := s.tokensService.TokenIDByAddress(platform, address); ok {
+			addressesByID[coinID] = append(addressesByID[coinID], address)
+		}
+	}
+
+	return addressesByID, nil
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/status-im/market-proxy/coingecko_tokens"
+	"github.com/status-im/market-proxy/config"
+)
+
+func TestHandleCoinByContract_InvalidAndUnknownAddress(t *testing.T) {
+	server := &Server{tokensService: coingecko_tokens.NewService(&config.Config{})}
+
+	recorder := httptest.NewRecorder()
+	server.handleCoinsRoutes(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/coins/ethereum/contract/0xa0B86991c6218b36c1d19D4a2e9Eb0cE3606eB48", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	server.handleCoinsRoutes(recorder, httptest.NewRequest(http.MethodGet,
+		"/api/v1/coins/ethereum/contract/0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestHandleSimpleTokenPrice_Validation(t *testing.T) {
+	server := &Server{tokensService: coingecko_tokens.NewService(&config.Config{})}
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/simple/token_price/{platform}", server.handleSimpleTokenPrice)
+
+	tests := []struct {
+		name         string
+		url          string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "missing contract addresses",
+			url:          "/api/v1/simple/token_price/ethereum?vs_currencies=usd",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "missing currencies",
+			url:          "/api/v1/simple/token_price/ethereum?contract_addresses=0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid checksum",
+			url:          "/api/v1/simple/token_price/ethereum?vs_currencies=usd&contract_addresses=0xa0B86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "too many contract addresses",
+			url:          "/api/v1/simple/token_price/ethereum?vs_currencies=usd&contract_addresses=" + strings.Repeat("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48,", maxContractAddresses+1),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unknown addresses are omitted",
+			url:          "/api/v1/simple/token_price/ethereum?vs_currencies=usd&contract_addresses=0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+			expectedCode: http.StatusOK,
+			expectedBody: "{}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
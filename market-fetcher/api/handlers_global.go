@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// handleGlobal implements CoinGecko-compatible /api/v3/global endpoint
+func (s *Server) handleGlobal(w http.ResponseWriter, r *http.Request) {
+	data, updatedAt := s.globalService.Global()
+	if data == nil {
+		http.Error(w, "Global market data is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.setAgeHeader(w, time.Since(updatedAt))
+	s.setFreshnessHeaders(w, updatedAt, s.getUpdateIntervals().global)
+	s.sendJSONResponse(w, r, data)
+}
+
+// handleSearchTrending implements CoinGecko-compatible /api/v3/search/trending endpoint
+func (s *Server) handleSearchTrending(w http.ResponseWriter, r *http.Request) {
+	data, updatedAt := s.trendingService.Trending()
+	if data == nil {
+		http.Error(w, "Trending data is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.setAgeHeader(w, time.Since(updatedAt))
+	s.setFreshnessHeaders(w, updatedAt, s.getUpdateIntervals().trending)
+	s.sendJSONResponse(w, r, data)
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/status-im/market-proxy/coingecko_global"
+	"github.com/status-im/market-proxy/coingecko_trending"
+	"github.com/status-im/market-proxy/config"
+)
+
+func TestHandleGlobalAndTrending_NotLoaded(t *testing.T) {
+	cfg := &config.Config{APITokens: &config.APITokens{}}
+	server := &Server{
+		globalService:   coingecko_global.NewService(cfg),
+		trendingService: coingecko_trending.NewService(cfg),
+	}
+
+	recorder := httptest.NewRecorder()
+	server.handleGlobal(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/global", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	server.handleSearchTrending(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/search/trending", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
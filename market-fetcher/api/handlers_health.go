@@ -16,6 +16,8 @@ func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 			"coingecko_market_chart": "unknown",
 			"coingecko_platforms":    "unknown",
 			"coingecko_coins":        "unknown",
+			"coingecko_global":       "unknown",
+			"coingecko_trending":     "unknown",
 		},
 	}
 
@@ -47,5 +49,17 @@ func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 		status["services"].(map[string]string)["coingecko_coins"] = "up"
 	}
 
-	s.sendJSONResponse(w, status)
+	if s.globalService.Healthy() {
+		status["services"].(map[string]string)["coingecko_global"] = "up"
+	}
+
+	if s.trendingService.Healthy() {
+		status["services"].(map[string]string)["coingecko_trending"] = "up"
+	}
+
+	if s.configWatcher != nil {
+		status["config"] = s.configWatcher.Version()
+	}
+
+	s.sendJSONResponse(w, r, status)
 }
@@ -6,16 +6,16 @@ import (
 
 // handleLeaderboardMarkets responds with market data from the leaderboard service
 func (s *Server) handleLeaderboardMarkets(w http.ResponseWriter, r *http.Request) {
-	data := s.cgService.GetCacheData()
-	if data == nil {
+	marketsResponse := s.cgService.GetCacheSnapshot()
+	if marketsResponse == nil {
 		emptyResponse := map[string]interface{}{
 			"data": []interface{}{},
 		}
-		s.sendJSONResponse(w, emptyResponse)
+		s.sendJSONResponse(w, r, emptyResponse)
 		return
 	}
 
-	s.sendJSONResponse(w, data)
+	s.sendSnapshot(w, r, marketsResponse, s.getUpdateIntervals().leaderboard)
 }
 
 // handleLeaderboardPrices responds with price quotes from Binance service
@@ -29,5 +29,5 @@ func (s *Server) handleLeaderboardSimplePrices(w http.ResponseWriter, r *http.Re
 	currency := getParamLowercase(r, "currency")
 
 	prices := s.cgService.GetTopPricesQuotes(currency)
-	s.sendJSONResponse(w, prices)
+	s.sendJSONResponse(w, r, prices)
 }
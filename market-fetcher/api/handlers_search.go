@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/status-im/market-proxy/search"
+)
+
+const (
+	defaultSearchLimit = 25
+	maxSearchLimit     = 250
+)
+
+// searchResponse mimics the coins part of CoinGecko /api/v3/search response
+type searchResponse struct {
+	Coins []search.Coin `json:"coins"`
+}
+
+// handleSearch searches the coins list by symbol, name and ID
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("query"))
+	if query == "" {
+		http.Error(w, "Parameter 'query' is required", http.StatusBadRequest)
+		return
+	}
+
+	limit := defaultSearchLimit
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed <= 0 || parsed > maxSearchLimit {
+			http.Error(w, "Parameter 'limit' must be between 1 and "+strconv.Itoa(maxSearchLimit), http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	if !s.searchService.Healthy() {
+		http.Error(w, "Search index is not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.sendJSONResponse(w, r, searchResponse{Coins: s.searchService.Search(query, limit)})
+}
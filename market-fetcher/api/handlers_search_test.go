@@ -0,0 +1,34 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/status-im/market-proxy/search"
+)
+
+func TestHandleSearch_Validation(t *testing.T) {
+	server := &Server{searchService: search.NewService(nil, nil)}
+
+	tests := []struct {
+		name         string
+		url          string
+		expectedCode int
+	}{
+		{"missing query", "/api/v1/search", http.StatusBadRequest},
+		{"invalid limit", "/api/v1/search?query=eth&limit=abc", http.StatusBadRequest},
+		{"limit too large", "/api/v1/search?query=eth&limit=1000", http.StatusBadRequest},
+		{"index not ready", "/api/v1/search?query=eth", http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			server.handleSearch(recorder, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/status-im/market-proxy/events"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// streamKeepAliveInterval is the interval between keep-alive comments sent to idle streams
+const streamKeepAliveInterval = 15 * time.Second
+
+// handleSimplePriceStream streams /simple/price data as Server-Sent Events.
+// The first "snapshot" event carries all requested prices, following "update" events
+// carry only the fields which changed since the previous event and the version of the
+// prices update as event ID.
+func (s *Server) handleSimplePriceStream(w http.ResponseWriter, r *http.Request) {
+	params, err := parseSimplePriceParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// Subscribe before reading the snapshot so that no update is lost in between
+	subscription := s.pricesService.SubscribeTopPricesUpdate()
+	defer subscription.Cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // disable nginx buffering
+	w.WriteHeader(http.StatusOK)
+
+	ctx := r.Context()
+	last, _, err := s.pricesService.SimplePricesWithMeta(ctx, params)
+	if err != nil {
+		log.Printf("Price stream: failed to fetch prices: %v", err)
+		return
+	}
+	if err := writeSSEEvent(w, "snapshot", 0, last); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case _, ok := <-subscription.Chan():
+			if !ok {
+				return
+			}
+
+			// Only requested IDs which were updated are read again
+			updates := subscription.Events()
+			changedIds, full := events.ChangedIDs(updates)
+			refreshParams := params
+			if !full {
+				refreshParams.IDs = intersectIds(params.IDs, changedIds)
+				if len(refreshParams.IDs) == 0 {
+					continue
+				}
+			}
+
+			current, _, err := s.pricesService.SimplePricesWithMeta(ctx, refreshParams)
+			if err != nil {
+				log.Printf("Price stream: failed to fetch prices: %v", err)
+				continue
+			}
+
+			diff := diffSimplePrices(last, current)
+			for tokenID, tokenData := range current {
+				last[tokenID] = tokenData
+			}
+			if len(diff) == 0 {
+				continue
+			}
+
+			if err := writeSSEEvent(w, "update", lastVersion(updates), diff); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeSSEEvent writes data as a single Server-Sent Event, id is omitted if zero
+func writeSSEEvent(w http.ResponseWriter, event string, id uint64, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if id > 0 {
+		_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", id, event, payload)
+	} else {
+		_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	}
+	return err
+}
+
+// lastVersion returns the highest version of events
+func lastVersion(updates []events.Event) uint64 {
+	var version uint64
+	for _, update := range updates {
+		if update.Version > version {
+			version = update.Version
+		}
+	}
+	return version
+}
+
+// intersectIds returns ids which are present in changedIds, preserving order of ids
+func intersectIds(ids, changedIds []string) []string {
+	changed := make(map[string]struct{}, len(changedIds))
+	for _, id := range changedIds {
+		changed[id] = struct{}{}
+	}
+
+	result := make([]string, 0)
+	for _, id := range ids {
+		if _, ok := changed[id]; ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// diffSimplePrices returns token fields of current response which differ from previous one
+func diffSimplePrices(previous, current interfaces.SimplePriceResponse) interfaces.SimplePriceResponse {
+	diff := make(interfaces.SimplePriceResponse)
+
+	for tokenID, tokenData := range current {
+		currentFields, ok := tokenData.(map[string]interface{})
+		if !ok {
+			if !reflect.DeepEqual(previous[tokenID], tokenData) {
+				diff[tokenID] = tokenData
+			}
+			continue
+		}
+
+		previousFields, _ := previous[tokenID].(map[string]interface{})
+		changedFields := make(map[string]interface{})
+		for field, value := range currentFields {
+			if previousValue, exists := previousFields[field]; !exists || !reflect.DeepEqual(previousValue, value) {
+				changedFields[field] = value
+			}
+		}
+
+		if len(changedFields) > 0 {
+			diff[tokenID] = changedFields
+		}
+	}
+
+	return diff
+}
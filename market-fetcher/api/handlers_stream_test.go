@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+func TestDiffSimplePrices(t *testing.T) {
+	previous := interfaces.SimplePriceResponse{
+		"bitcoin":  map[string]interface{}{"usd": 50000.0, "eur": 42000.0},
+		"ethereum": map[string]interface{}{"usd": 3000.0},
+	}
+	current := interfaces.SimplePriceResponse{
+		"bitcoin":  map[string]interface{}{"usd": 50100.0, "eur": 42000.0},
+		"ethereum": map[string]interface{}{"usd": 3000.0},
+		"solana":   map[string]interface{}{"usd": 150.0},
+	}
+
+	diff := diffSimplePrices(previous, current)
+	assert.Equal(t, interfaces.SimplePriceResponse{
+		"bitcoin": map[string]interface{}{"usd": 50100.0},
+		"solana":  map[string]interface{}{"usd": 150.0},
+	}, diff)
+
+	assert.Empty(t, diffSimplePrices(current, current))
+}
+
+func TestWriteSSEEvent(t *testing.T) {
+	recorder := httptest.NewRecorder()
+
+	err := writeSSEEvent(recorder, "update", 7, map[string]interface{}{"bitcoin": map[string]interface{}{"usd": 1}})
+	require.NoError(t, err)
+	assert.Equal(t, "id: 7\nevent: update\ndata: {\"bitcoin\":{\"usd\":1}}\n\n", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	err = writeSSEEvent(recorder, "snapshot", 0, map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Equal(t, "event: snapshot\ndata: {}\n\n", recorder.Body.String())
+}
+
+func TestIntersectIds(t *testing.T) {
+	assert.Equal(t, []string{"bitcoin", "solana"}, intersectIds([]string{"bitcoin", "ethereum", "solana"}, []string{"solana", "bitcoin", "dogecoin"}))
+	assert.Empty(t, intersectIds([]string{"bitcoin"}, []string{"ethereum"}))
+}
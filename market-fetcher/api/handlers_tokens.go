@@ -6,21 +6,21 @@ import (
 	"github.com/gorilla/mux"
 )
 
-// TokenListHandler handles requests for token lists by platform
+// TokenListHandler handles requests for token lists by platform ID or chain ID
 func (s *Server) TokenListHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	platform := vars["platform"]
+	platform := s.resolvePlatform(vars["platform"])
 
 	if platform == "" {
 		http.Error(w, "Platform parameter is required", http.StatusBadRequest)
 		return
 	}
 
-	tokenListResponse := s.tokenListService.GetTokenList(platform)
-	if tokenListResponse.Error != nil {
-		http.Error(w, tokenListResponse.Error.Error(), http.StatusBadRequest)
+	tokenListResponse, err := s.tokenListService.GetTokenListSnapshot(platform)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	s.sendJSONResponse(w, tokenListResponse.TokenList)
+	s.sendSnapshot(w, r, tokenListResponse, s.getUpdateIntervals().tokenLists)
 }
@@ -2,14 +2,14 @@ package api
 
 import (
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/status-im/market-proxy/response"
 )
 
 // setCacheStatusHeader sets the Cache-Status header based on cache status
@@ -19,9 +19,37 @@ func (s *Server) setCacheStatusHeader(w http.ResponseWriter, cacheStatus string)
 	}
 }
 
-// sendJSONResponse is a common wrapper for JSON responses that sets Content-Type,
-// Content-Length and ETag headers
-func (s *Server) sendJSONResponse(w http.ResponseWriter, data interface{}) {
+// setAgeHeader sets the Age header (in seconds) for data served from cache
+func (s *Server) setAgeHeader(w http.ResponseWriter, age time.Duration) {
+	if age > 0 {
+		w.Header().Set("Age", strconv.FormatInt(int64(age/time.Second), 10))
+	}
+}
+
+// setDerivedCurrenciesHeader sets the X-Derived-Currencies header listing currencies
+// whose values were converted using exchange rates
+func (s *Server) setDerivedCurrenciesHeader(w http.ResponseWriter, currencies []string) {
+	if len(currencies) > 0 {
+		w.Header().Set("X-Derived-Currencies", strings.Join(currencies, ","))
+	}
+}
+
+// setFreshnessHeaders sets Last-Modified to the time the data was updated and Cache-Control
+// max-age to the time left until the next update
+func (s *Server) setFreshnessHeaders(w http.ResponseWriter, updatedAt time.Time, updateInterval time.Duration) {
+	if updatedAt.IsZero() {
+		return
+	}
+
+	w.Header().Set("Last-Modified", updatedAt.UTC().Format(http.TimeFormat))
+	if updateInterval > 0 {
+		maxAge := max(updateInterval-time.Since(updatedAt), 0)
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.FormatInt(int64(maxAge/time.Second), 10))
+	}
+}
+
+// sendJSONResponse is a common wrapper for JSON responses, see sendResponseBytes
+func (s *Server) sendJSONResponse(w http.ResponseWriter, r *http.Request, data interface{}) {
 	// Marshal the data to calculate content length and ETag
 	responseBytes, err := json.Marshal(data)
 	if err != nil {
@@ -29,22 +57,110 @@ func (s *Server) sendJSONResponse(w http.ResponseWriter, data interface{}) {
 		return
 	}
 
-	// Calculate ETag (MD5 hash of the response)
-	hash := md5.Sum(responseBytes)
-	etag := hex.EncodeToString(hash[:])
+	s.sendResponseBytes(w, r, "application/json", responseBytes)
+}
+
+// sendResponseBytes sends the body compressed on the fly, see writeResponse
+func (s *Server) sendResponseBytes(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	s.writeResponse(w, r, contentType, body, response.ETag(body), func(encoding string) ([]byte, error) {
+		return response.Compress(encoding, body)
+	})
+}
+
+// sendSnapshot sends a pre-serialized JSON response and its pre-compressed copy, see writeResponse.
+// updateInterval is the update interval of the snapshot service, used for Cache-Control max-age.
+func (s *Server) sendSnapshot(w http.ResponseWriter, r *http.Request, snapshot *response.Snapshot, updateInterval time.Duration) {
+	s.setFreshnessHeaders(w, snapshot.UpdatedAt(), updateInterval)
+	s.writeResponse(w, r, "application/json", snapshot.Body(), snapshot.ETag(), func(encoding string) ([]byte, error) {
+		if compressed, exists := snapshot.Compressed(encoding); exists {
+			return compressed, nil
+		}
+		return response.Compress(encoding, snapshot.Body())
+	})
+}
 
-	// Set headers
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
-	w.Header().Set("ETag", "\""+etag+"\"")
+// writeResponse sets Content-Type, Content-Length and ETag headers, answers conditional
+// requests with 304 Not Modified and sends the body compressed with the encoding accepted by the client
+func (s *Server) writeResponse(w http.ResponseWriter, r *http.Request, contentType string, body []byte, etag string, compress func(encoding string) ([]byte, error)) {
+	encoding := ""
+	if len(body) >= response.MinCompressSize {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding = response.NegotiateEncoding(r.Header.Get("Accept-Encoding"))
+	}
+
+	// Compressed representations have their own ETags
+	if encoding != "" {
+		w.Header().Set("ETag", "\""+etag+"-"+encoding+"\"")
+	} else {
+		w.Header().Set("ETag", "\""+etag+"\"")
+	}
+
+	if isNotModified(r, etag, w.Header().Get("Last-Modified")) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if encoding != "" {
+		compressed, err := compress(encoding)
+		if err != nil {
+			log.Printf("Error compressing response with %s: %v", encoding, err)
+			w.Header().Set("ETag", "\""+etag+"\"")
+		} else {
+			body = compressed
+			w.Header().Set("Content-Encoding", encoding)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
 
 	// Write the response
-	if _, err := w.Write(responseBytes); err != nil {
+	if _, err := w.Write(body); err != nil {
 		log.Printf("Error writing response: %v", err)
 		return
 	}
 }
 
+// isNotModified checks conditional request headers of GET and HEAD requests.
+// If-None-Match takes precedence over If-Modified-Since, tags of compressed representations
+// match the uncompressed one.
+func isNotModified(r *http.Request, etag string, lastModified string) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" {
+				return true
+			}
+			tag = strings.Trim(strings.TrimPrefix(tag, "W/"), "\"")
+			for _, encoding := range response.Encodings {
+				tag = strings.TrimSuffix(tag, "-"+encoding)
+			}
+			if tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ifModifiedSince := r.Header.Get("If-Modified-Since")
+	if ifModifiedSince == "" || lastModified == "" {
+		return false
+	}
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(lastModified)
+	if err != nil {
+		return false
+	}
+	return !modified.After(since)
+}
+
 // Stop gracefully shuts down the server
 func (s *Server) Stop() {
 	if s.server != nil {
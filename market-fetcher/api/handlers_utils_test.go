@@ -4,10 +4,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/response"
 )
 
 func TestGetParamLowercase(t *testing.T) {
@@ -206,7 +212,7 @@ func TestSendJSONResponse(t *testing.T) {
 			server := &Server{}
 			recorder := httptest.NewRecorder()
 
-			server.sendJSONResponse(recorder, tt.data)
+			server.sendJSONResponse(recorder, httptest.NewRequest("GET", "/", nil), tt.data)
 
 			// Check status code
 			assert.Equal(t, http.StatusOK, recorder.Code)
@@ -231,3 +237,241 @@ func TestSendJSONResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestSetAgeHeader(t *testing.T) {
+	s := &Server{}
+
+	w := httptest.NewRecorder()
+	s.setAgeHeader(w, 90*time.Second+500*time.Millisecond)
+	assert.Equal(t, "90", w.Header().Get("Age"))
+
+	w = httptest.NewRecorder()
+	s.setAgeHeader(w, 0)
+	assert.Empty(t, w.Header().Get("Age"))
+}
+
+func TestSetFreshnessHeaders(t *testing.T) {
+	s := &Server{}
+	updatedAt := time.Now().Add(-20 * time.Second)
+
+	w := httptest.NewRecorder()
+	s.setFreshnessHeaders(w, updatedAt, time.Minute)
+	assert.Equal(t, updatedAt.UTC().Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+	assert.Contains(t, []string{"public, max-age=39", "public, max-age=40"}, w.Header().Get("Cache-Control"))
+
+	// Data older than the update interval must be revalidated
+	w = httptest.NewRecorder()
+	s.setFreshnessHeaders(w, time.Now().Add(-2*time.Minute), time.Minute)
+	assert.Equal(t, "public, max-age=0", w.Header().Get("Cache-Control"))
+
+	w = httptest.NewRecorder()
+	s.setFreshnessHeaders(w, time.Time{}, time.Minute)
+	assert.Empty(t, w.Header().Get("Last-Modified"))
+	assert.Empty(t, w.Header().Get("Cache-Control"))
+}
+
+func TestSendResponseBytes_ConditionalRequests(t *testing.T) {
+	server := &Server{}
+	body := []byte(`{"bitcoin":{"usd":100000}}`)
+
+	send := func(method string, headers map[string]string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, "/", nil)
+		for key, value := range headers {
+			request.Header.Set(key, value)
+		}
+		recorder := httptest.NewRecorder()
+		recorder.Header().Set("Last-Modified", "Wed, 14 Oct 2026 10:00:00 GMT")
+		server.sendResponseBytes(recorder, request, "application/json", body)
+		return recorder
+	}
+
+	etag := send("GET", nil).Header().Get("ETag")
+	weakETag := "W/" + etag
+	compressedETag := strings.TrimSuffix(etag, `"`) + `-gzip"`
+
+	tests := []struct {
+		name     string
+		method   string
+		headers  map[string]string
+		expected int
+	}{
+		{"matching etag", "GET", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{"matching etag in list", "GET", map[string]string{"If-None-Match": `"other", ` + etag}, http.StatusNotModified},
+		{"weak etag", "GET", map[string]string{"If-None-Match": weakETag}, http.StatusNotModified},
+		{"etag of compressed representation", "GET", map[string]string{"If-None-Match": compressedETag}, http.StatusNotModified},
+		{"wildcard", "HEAD", map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+		{"different etag", "GET", map[string]string{"If-None-Match": `"other"`}, http.StatusOK},
+		{"post ignores etag", "POST", map[string]string{"If-None-Match": etag}, http.StatusOK},
+		{"not modified since", "GET", map[string]string{"If-Modified-Since": "Wed, 14 Oct 2026 10:00:00 GMT"}, http.StatusNotModified},
+		{"modified since", "GET", map[string]string{"If-Modified-Since": "Wed, 14 Oct 2026 09:00:00 GMT"}, http.StatusOK},
+		{"etag takes precedence", "GET", map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": "Wed, 14 Oct 2026 10:00:00 GMT"}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := send(tt.method, tt.headers)
+			assert.Equal(t, tt.expected, recorder.Code)
+			assert.Equal(t, etag, recorder.Header().Get("ETag"))
+			if tt.expected == http.StatusNotModified {
+				assert.Empty(t, recorder.Body.Bytes())
+			} else {
+				assert.Equal(t, body, recorder.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestSendResponseBytes_Compression(t *testing.T) {
+	server := &Server{}
+	body := []byte(`[` + strings.Repeat(`{"id":"bitcoin","symbol":"btc","name":"Bitcoin"},`, 100) + `{}]`)
+
+	for _, encoding := range response.Encodings {
+		t.Run(encoding, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "/", nil)
+			request.Header.Set("Accept-Encoding", encoding)
+			recorder := httptest.NewRecorder()
+
+			server.sendResponseBytes(recorder, request, "application/json", body)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, encoding, recorder.Header().Get("Content-Encoding"))
+			assert.Equal(t, "Accept-Encoding", recorder.Header().Get("Vary"))
+			assert.True(t, strings.HasSuffix(recorder.Header().Get("ETag"), "-"+encoding+`"`))
+			assert.Equal(t, strconv.Itoa(recorder.Body.Len()), recorder.Header().Get("Content-Length"))
+			assert.Less(t, recorder.Body.Len(), len(body))
+
+			expected, err := response.Compress(encoding, body)
+			require.NoError(t, err)
+			assert.Equal(t, expected, recorder.Body.Bytes())
+		})
+	}
+
+	t.Run("small body is not compressed", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "/", nil)
+		request.Header.Set("Accept-Encoding", "gzip")
+		recorder := httptest.NewRecorder()
+
+		server.sendResponseBytes(recorder, request, "application/json", []byte(`{}`))
+
+		assert.Empty(t, recorder.Header().Get("Content-Encoding"))
+		assert.Equal(t, `{}`, recorder.Body.String())
+	})
+}
+
+func TestSendSnapshot(t *testing.T) {
+	server := &Server{}
+	data := []map[string]string{}
+	for i := 0; i < 100; i++ {
+		data = append(data, map[string]string{"id": "bitcoin", "symbol": "btc", "name": "Bitcoin"})
+	}
+	updatedAt := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	snapshot, err := response.NewSnapshot(data, updatedAt)
+	require.NoError(t, err)
+
+	// Snapshot is served byte-for-byte like a marshalled response
+	expected := httptest.NewRecorder()
+	server.sendJSONResponse(expected, httptest.NewRequest("GET", "/", nil), data)
+	recorder := httptest.NewRecorder()
+	server.sendSnapshot(recorder, httptest.NewRequest("GET", "/", nil), snapshot, 0)
+	assert.Equal(t, expected.Body.Bytes(), recorder.Body.Bytes())
+	assert.Equal(t, expected.Header().Get("ETag"), recorder.Header().Get("ETag"))
+	assert.Equal(t, "Wed, 14 Oct 2026 10:00:00 GMT", recorder.Header().Get("Last-Modified"))
+
+	// max-age is based on the update interval of the snapshot service
+	fresh, err := response.NewSnapshot(data, time.Now())
+	require.NoError(t, err)
+	recorder = httptest.NewRecorder()
+	server.sendSnapshot(recorder, httptest.NewRequest("GET", "/", nil), fresh, time.Hour)
+	assert.Contains(t, []string{"public, max-age=3599", "public, max-age=3600"}, recorder.Header().Get("Cache-Control"))
+
+	request := httptest.NewRequest("GET", "/", nil)
+	request.Header.Set("Accept-Encoding", "br")
+	recorder = httptest.NewRecorder()
+	server.sendSnapshot(recorder, request, snapshot, 0)
+	compressed, _ := snapshot.Compressed(response.EncodingBrotli)
+	assert.Equal(t, "br", recorder.Header().Get("Content-Encoding"))
+	assert.Equal(t, compressed, recorder.Body.Bytes())
+
+	request = httptest.NewRequest("GET", "/", nil)
+	request.Header.Set("If-None-Match", `"`+snapshot.ETag()+`"`)
+	recorder = httptest.NewRecorder()
+	server.sendSnapshot(recorder, request, snapshot, 0)
+	assert.Equal(t, http.StatusNotModified, recorder.Code)
+}
+
+// discardResponseWriter is a response writer for benchmarks which doesn't buffer the body
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header { return w.header }
+
+func (w *discardResponseWriter) Write(body []byte) (int, error) { return len(body), nil }
+
+func (w *discardResponseWriter) WriteHeader(int) {}
+
+// benchmarkTokens returns a /coins/list sized data set
+func benchmarkTokens() []interfaces.Token {
+	tokens := make([]interfaces.Token, 0, 10000)
+	for i := 0; i < cap(tokens); i++ {
+		id := "token-" + strconv.Itoa(i)
+		tokens = append(tokens, interfaces.Token{
+			ID:        id,
+			Symbol:    "tkn" + strconv.Itoa(i),
+			Name:      "Token " + strconv.Itoa(i),
+			Platforms: map[string]string{"ethereum": "0x" + strings.Repeat(strconv.Itoa(i%10), 40)},
+		})
+	}
+	return tokens
+}
+
+func benchmarkSend(b *testing.B, acceptEncoding string, send func(w http.ResponseWriter, r *http.Request)) {
+	request := httptest.NewRequest("GET", "/api/v1/coins/list", nil)
+	if acceptEncoding != "" {
+		request.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		send(&discardResponseWriter{header: make(http.Header)}, request)
+	}
+}
+
+func BenchmarkSendJSONResponse(b *testing.B) {
+	server := &Server{}
+	tokens := benchmarkTokens()
+
+	benchmarkSend(b, "", func(w http.ResponseWriter, r *http.Request) {
+		server.sendJSONResponse(w, r, tokens)
+	})
+}
+
+func BenchmarkSendJSONResponse_Gzip(b *testing.B) {
+	server := &Server{}
+	tokens := benchmarkTokens()
+
+	benchmarkSend(b, "gzip", func(w http.ResponseWriter, r *http.Request) {
+		server.sendJSONResponse(w, r, tokens)
+	})
+}
+
+func BenchmarkSendSnapshot(b *testing.B) {
+	server := &Server{}
+	snapshot, err := response.NewSnapshot(benchmarkTokens(), time.Now())
+	require.NoError(b, err)
+
+	benchmarkSend(b, "", func(w http.ResponseWriter, r *http.Request) {
+		server.sendSnapshot(w, r, snapshot, time.Minute)
+	})
+}
+
+func BenchmarkSendSnapshot_Gzip(b *testing.B) {
+	server := &Server{}
+	snapshot, err := response.NewSnapshot(benchmarkTokens(), time.Now())
+	require.NoError(b, err)
+
+	benchmarkSend(b, "gzip", func(w http.ResponseWriter, r *http.Request) {
+		server.sendSnapshot(w, r, snapshot, time.Minute)
+	})
+}
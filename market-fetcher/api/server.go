@@ -4,13 +4,22 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/status-im/market-proxy/clientauth"
 	"github.com/status-im/market-proxy/coingecko_assets_platforms"
+	"github.com/status-im/market-proxy/coingecko_categories"
 	"github.com/status-im/market-proxy/coingecko_coins"
+	"github.com/status-im/market-proxy/coingecko_global"
 	"github.com/status-im/market-proxy/coingecko_market_chart"
 	"github.com/status-im/market-proxy/coingecko_markets"
+	"github.com/status-im/market-proxy/coingecko_ohlc"
 	"github.com/status-im/market-proxy/coingecko_token_list"
+	"github.com/status-im/market-proxy/coingecko_trending"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/search"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	coingecko "github.com/status-im/market-proxy/coingecko_leaderboard"
@@ -25,39 +34,135 @@ type Server struct {
 	pricesService          *coingecko_prices.Service
 	marketsService         *coingecko_markets.Service
 	marketChartService     *coingecko_market_chart.Service
+	ohlcService            *coingecko_ohlc.Service
 	assetsPlatformsService *coingecko_assets_platforms.Service
 	tokenListService       *coingecko_token_list.Service
 	coinsService           *coingecko_coins.Service
+	searchService          *search.Service
+	categoriesService      *coingecko_categories.Service
+	globalService          *coingecko_global.Service
+	trendingService        *coingecko_trending.Service
+	configWatcher          *config.Watcher
+	clientAuth             *clientauth.Service
 	server                 *http.Server
+	intervals              struct {
+		sync.RWMutex
+		updateIntervals
+	}
+}
+
+// updateIntervals are update intervals of cached data, used for Cache-Control max-age
+type updateIntervals struct {
+	global      time.Duration
+	trending    time.Duration
+	prices      time.Duration
+	platforms   time.Duration
+	coinsList   time.Duration
+	tokenLists  time.Duration
+	leaderboard time.Duration // leaderboard is updated with markets tiers
 }
 
-func New(port string, cgService *coingecko.Service, tokensService *coingecko_tokens.Service, pricesService *coingecko_prices.Service, marketsService *coingecko_markets.Service, marketChartService *coingecko_market_chart.Service, assetsPlatformsService *coingecko_assets_platforms.Service, tokenListService *coingecko_token_list.Service, coinsService *coingecko_coins.Service) *Server {
+// Dependencies are services the HTTP API is served from
+type Dependencies struct {
+	Leaderboard     *coingecko.Service
+	Tokens          *coingecko_tokens.Service
+	Prices          *coingecko_prices.Service
+	Markets         *coingecko_markets.Service
+	MarketChart     *coingecko_market_chart.Service
+	OHLC            *coingecko_ohlc.Service
+	AssetsPlatforms *coingecko_assets_platforms.Service
+	TokenList       *coingecko_token_list.Service
+	Coins           *coingecko_coins.Service
+	Search          *search.Service
+	Categories      *coingecko_categories.Service
+	Global          *coingecko_global.Service
+	Trending        *coingecko_trending.Service
+}
+
+func New(port string, deps Dependencies) *Server {
 	return &Server{
 		port:                   port,
-		cgService:              cgService,
-		tokensService:          tokensService,
-		pricesService:          pricesService,
-		marketsService:         marketsService,
-		marketChartService:     marketChartService,
-		assetsPlatformsService: assetsPlatformsService,
-		tokenListService:       tokenListService,
-		coinsService:           coinsService,
+		cgService:              deps.Leaderboard,
+		tokensService:          deps.Tokens,
+		pricesService:          deps.Prices,
+		marketsService:         deps.Markets,
+		marketChartService:     deps.MarketChart,
+		ohlcService:            deps.OHLC,
+		assetsPlatformsService: deps.AssetsPlatforms,
+		tokenListService:       deps.TokenList,
+		coinsService:           deps.Coins,
+		searchService:          deps.Search,
+		categoriesService:      deps.Categories,
+		globalService:          deps.Global,
+		trendingService:        deps.Trending,
+	}
+}
+
+// SetConfigWatcher sets the watcher whose active config version is reported in /health
+func (s *Server) SetConfigWatcher(watcher *config.Watcher) {
+	s.configWatcher = watcher
+}
+
+// SetClientAuth enables authentication and per-consumer quotas of API requests
+func (s *Server) SetClientAuth(clientAuth *clientauth.Service) {
+	s.clientAuth = clientAuth
+}
+
+// ApplyConfig implements core.IReloadable, update intervals advertised in Cache-Control are changed live
+func (s *Server) ApplyConfig(cfg *config.Config) {
+	s.intervals.Lock()
+	defer s.intervals.Unlock()
+
+	s.intervals.updateIntervals = updateIntervals{
+		global:      cfg.CoingeckoGlobal.GetUpdateInterval(),
+		trending:    cfg.CoingeckoTrending.GetUpdateInterval(),
+		prices:      cfg.CoingeckoPrices.GetMinUpdateInterval(),
+		platforms:   cfg.CoingeckoPlatforms.GetUpdateInterval(),
+		coinsList:   cfg.TokensFetcher.UpdateInterval,
+		tokenLists:  cfg.TokenListFetcher.UpdateInterval,
+		leaderboard: cfg.CoingeckoMarkets.GetMinUpdateInterval(),
 	}
 }
 
+// getUpdateIntervals returns update intervals of the active config
+func (s *Server) getUpdateIntervals() updateIntervals {
+	s.intervals.RLock()
+	defer s.intervals.RUnlock()
+
+	return s.intervals.updateIntervals
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	router := mux.NewRouter()
 
+	if s.clientAuth != nil {
+		router.Use(s.clientAuth.Middleware)
+	}
+
 	// Existing endpoints
 	router.HandleFunc("/api/v1/leaderboard/prices", s.handleLeaderboardPrices)
 	router.HandleFunc("/api/v1/leaderboard/simpleprices", s.handleLeaderboardSimplePrices)
 	router.HandleFunc("/api/v1/leaderboard/markets", s.handleLeaderboardMarkets)
 	router.HandleFunc("/api/v1/asset_platforms", s.handleAssetsPlatforms)
 	router.HandleFunc("/api/v1/simple/price", s.handleSimplePrice)
+	router.HandleFunc("/api/v1/simple/token_price/{platform}", s.handleSimpleTokenPrice)
+
+	// Server-Sent Events stream of price updates
+	router.HandleFunc("/api/v1/stream/simple/price", s.handleSimplePriceStream).Methods("GET")
+
+	// Batch coins endpoint, POST variant accepts IDs in JSON body
+	router.HandleFunc("/api/v1/coins", s.handleCoinsBatch).Methods("GET", "POST")
 
 	// All coins endpoints are handled by the coins router
 	router.PathPrefix("/api/v1/coins/").HandlerFunc(s.handleCoinsRoutes)
 
+	// Local search over the coins list
+	router.HandleFunc("/api/v1/search", s.handleSearch).Methods("GET")
+
+	// Global market stats and trending coins
+	router.HandleFunc("/api/v1/global", s.handleGlobal).Methods("GET")
+	router.HandleFunc("/api/v1/search/trending", s.handleSearchTrending).Methods("GET")
+
 	// Token list endpoint
 	router.HandleFunc("/api/v1/token_lists/{platform}/all.json", s.TokenListHandler).Methods("GET")
 
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const defaultBoltBucket = "cache"
+
+// BoltStore persistent on-disk L2 store backed by BoltDB
+type BoltStore struct {
+	db     *bolt.DB
+	bucket []byte
+}
+
+// NewBoltStore opens (or creates) BoltDB file at the configured path
+func NewBoltStore(config BoltConfig) (*BoltStore, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("bolt store path is not configured")
+	}
+
+	if dir := filepath.Dir(config.Path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create bolt store directory: %w", err)
+		}
+	}
+
+	db, err := bolt.Open(config.Path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt store: %w", err)
+	}
+
+	bucket := []byte(config.Bucket)
+	if len(bucket) == 0 {
+		bucket = []byte(defaultBoltBucket)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create bolt bucket: %w", err)
+	}
+
+	return &BoltStore{
+		db:     db,
+		bucket: bucket,
+	}, nil
+}
+
+// Get retrieves non-expired entries by keys
+func (s *BoltStore) Get(keys []string) (map[string]StoreEntry, []string, error) {
+	found := make(map[string]StoreEntry)
+	missing := make([]string, 0)
+	now := time.Now()
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		for _, key := range keys {
+			raw := b.Get([]byte(key))
+			if raw == nil {
+				missing = append(missing, key)
+				continue
+			}
+			entry, err := decodeStoreEntry(raw)
+			if err != nil || entry.Expired(now) {
+				missing = append(missing, key)
+				continue
+			}
+			found[key] = entry
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, keys, fmt.Errorf("bolt store get failed: %w", err)
+	}
+
+	return found, missing, nil
+}
+
+// Set stores entries in a single transaction
+func (s *BoltStore) Set(entries map[string]StoreEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		for key, entry := range entries {
+			if err := b.Put([]byte(key), encodeStoreEntry(entry)); err != nil {
+				return fmt.Errorf("bolt store put %s failed: %w", key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Delete removes entries by keys
+func (s *BoltStore) Delete(keys []string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		for _, key := range keys {
+			if err := b.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteExpired removes all expired entries from the store
+func (s *BoltStore) DeleteExpired() (int, error) {
+	now := time.Now()
+	deleted := 0
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			entry, err := decodeStoreEntry(v)
+			if err == nil && !entry.Expired(now) {
+				continue
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+			deleted++
+		}
+		return nil
+	})
+
+	return deleted, err
+}
+
+// Close closes underlying BoltDB file
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
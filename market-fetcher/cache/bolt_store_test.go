@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBoltStore(t *testing.T, path string) *BoltStore {
+	store, err := NewBoltStore(BoltConfig{Path: path})
+	require.NoError(t, err)
+	return store
+}
+
+// testEntries builds store entries from data with the given TTL
+func testEntries(data map[string][]byte, ttl time.Duration) map[string]StoreEntry {
+	now := time.Now()
+	entries := make(map[string]StoreEntry, len(data))
+	for key, value := range data {
+		entries[key] = StoreEntry{Data: value, StoredAt: now, ExpiresAt: expiresAt(now, ttl)}
+	}
+	return entries
+}
+
+func TestBoltStore_SetGet(t *testing.T) {
+	store := newTestBoltStore(t, filepath.Join(t.TempDir(), "cache.db"))
+	defer store.Close()
+
+	err := store.Set(testEntries(map[string][]byte{"key1": []byte("value1"), "key2": []byte("value2")}, time.Minute))
+	require.NoError(t, err)
+
+	found, missing, err := store.Get([]string{"key1", "key2", "key3"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value1"), found["key1"].Data)
+	assert.Equal(t, []byte("value2"), found["key2"].Data)
+	assert.Equal(t, []string{"key3"}, missing)
+	assert.InDelta(t, time.Minute.Seconds(), found["key1"].TTL(time.Now()).Seconds(), 1)
+}
+
+func TestBoltStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+
+	store := newTestBoltStore(t, path)
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"key": []byte("value")}, NoExpiration)))
+	require.NoError(t, store.Close())
+
+	store = newTestBoltStore(t, path)
+	defer store.Close()
+
+	found, missing, err := store.Get([]string{"key"})
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.Equal(t, []byte("value"), found["key"].Data)
+	assert.True(t, found["key"].ExpiresAt.IsZero())
+}
+
+func TestBoltStore_ExpiredEntries(t *testing.T) {
+	store := newTestBoltStore(t, filepath.Join(t.TempDir(), "cache.db"))
+	defer store.Close()
+
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"short": []byte("value")}, 10*time.Millisecond)))
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"long": []byte("value")}, time.Hour)))
+	time.Sleep(20 * time.Millisecond)
+
+	_, missing, err := store.Get([]string{"short", "long"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"short"}, missing)
+
+	deleted, err := store.DeleteExpired()
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
+
+func TestBoltStore_Delete(t *testing.T) {
+	store := newTestBoltStore(t, filepath.Join(t.TempDir(), "cache.db"))
+	defer store.Close()
+
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"key1": []byte("v"), "key2": []byte("v")}, time.Minute)))
+	require.NoError(t, store.Delete([]string{"key1"}))
+
+	found, missing, err := store.Get([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, []string{"key1"}, missing)
+}
+
+func TestNewBoltStore_EmptyPath(t *testing.T) {
+	_, err := NewBoltStore(BoltConfig{})
+	assert.Error(t, err)
+}
+
+func TestBoltStore_PreservesFreshness(t *testing.T) {
+	store := newTestBoltStore(t, filepath.Join(t.TempDir(), "cache.db"))
+	defer store.Close()
+
+	now := time.Now()
+	entry := StoreEntry{
+		Data:      []byte("value"),
+		StoredAt:  now,
+		StaleAt:   now.Add(time.Minute),
+		ExpiresAt: now.Add(time.Hour),
+	}
+	require.NoError(t, store.Set(map[string]StoreEntry{"key": entry}))
+
+	found, _, err := store.Get([]string{"key"})
+	require.NoError(t, err)
+	assert.Equal(t, entry.StoredAt.UnixNano(), found["key"].StoredAt.UnixNano())
+	assert.Equal(t, entry.StaleAt.UnixNano(), found["key"].StaleAt.UnixNano())
+	assert.Equal(t, entry.ExpiresAt.UnixNano(), found["key"].ExpiresAt.UnixNano())
+}
@@ -7,6 +7,21 @@ import "time"
 // and should return a key->data map for those keys.
 type LoaderFunc func(missingKeys []string) (map[string][]byte, error)
 
+// Item represents cached data with freshness information
+type Item struct {
+	Data     []byte
+	StoredAt time.Time // zero if unknown
+	Stale    bool      // soft TTL has expired, data should be refreshed
+}
+
+// Age returns how long the item has been in cache
+func (i Item) Age(now time.Time) time.Duration {
+	if i.StoredAt.IsZero() || now.Before(i.StoredAt) {
+		return 0
+	}
+	return now.Sub(i.StoredAt)
+}
+
 //go:generate mockgen -destination=mocks/cache.go . ICache
 
 // ICache interface for universal multi-level cache
@@ -45,4 +60,24 @@ type ICache interface {
 	// Returns:
 	// - error: execution error
 	Set(data map[string][]byte, ttl time.Duration) error
+
+	// GetItems retrieves data with freshness information by keys from cache
+	// Stale items (soft TTL expired) are returned with Stale flag set
+	//
+	// Returns:
+	// - map[string]Item: key->item map for found keys
+	// - []string: list of missing keys
+	// - error: execution error
+	GetItems(keys []string) (map[string]Item, []string, error)
+
+	// SetWithSoftTTL stores data which becomes stale after softTTL and is removed after hardTTL
+	//
+	// Parameters:
+	// - data: key->data map to store in cache
+	// - softTTL: time after which data is served as stale
+	// - hardTTL: time after which data is removed; if less than softTTL, softTTL is used
+	//
+	// Returns:
+	// - error: execution error
+	SetWithSoftTTL(data map[string][]byte, softTTL, hardTTL time.Duration) error
 }
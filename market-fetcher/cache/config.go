@@ -6,6 +6,9 @@ import "time"
 type Config struct {
 	// GoCache configuration
 	GoCache GoCacheConfig `yaml:"go_cache"`
+
+	// L2 persistent second-level cache configuration
+	L2 L2Config `yaml:"l2"`
 }
 
 // GoCacheConfig configuration for in-memory go-cache
@@ -21,6 +24,52 @@ type GoCacheConfig struct {
 	CleanupInterval time.Duration `yaml:"cleanup_interval"`
 }
 
+// L2 store types
+const (
+	L2TypeNone  = ""
+	L2TypeBolt  = "bolt"
+	L2TypeRedis = "redis"
+)
+
+// L2Config configuration for persistent second-level cache
+type L2Config struct {
+	// Type of L2 store: "bolt", "redis" or empty to disable L2
+	Type string `yaml:"type"`
+
+	// Bolt configuration for local on-disk store
+	Bolt BoltConfig `yaml:"bolt"`
+
+	// Redis configuration for Redis-protocol store
+	Redis RedisConfig `yaml:"redis"`
+}
+
+// BoltConfig configuration for BoltDB file store
+type BoltConfig struct {
+	// Path to the database file
+	Path string `yaml:"path"`
+
+	// Bucket name, "cache" by default
+	Bucket string `yaml:"bucket"`
+}
+
+// RedisConfig configuration for Redis-protocol store
+type RedisConfig struct {
+	Addr     string `yaml:"addr"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+
+	// KeyPrefix is prepended to every key, e.g. "market-fetcher:"
+	KeyPrefix string `yaml:"key_prefix"`
+
+	// Timeout for dial, read and write operations, 2s by default
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// Enabled returns true if L2 store is configured
+func (c L2Config) Enabled() bool {
+	return c.Type != L2TypeNone
+}
+
 // DefaultCacheConfig returns default cache configuration
 func DefaultCacheConfig() Config {
 	return Config{
@@ -48,3 +48,32 @@ go_cache:
 	assert.Equal(t, 15*time.Minute, config.GoCache.DefaultExpiration)
 	assert.Equal(t, 30*time.Minute, config.GoCache.CleanupInterval)
 }
+
+func TestConfig_L2YAMLDeserialization(t *testing.T) {
+	yamlData := `
+go_cache:
+  default_expiration: 5m
+l2:
+  type: redis
+  bolt:
+    path: /data/cache.db
+  redis:
+    addr: localhost:6379
+    db: 2
+    key_prefix: "market-fetcher:"
+    timeout: 1s
+`
+
+	var config Config
+	err := yaml.Unmarshal([]byte(yamlData), &config)
+	assert.NoError(t, err)
+
+	assert.True(t, config.L2.Enabled())
+	assert.Equal(t, L2TypeRedis, config.L2.Type)
+	assert.Equal(t, "/data/cache.db", config.L2.Bolt.Path)
+	assert.Equal(t, "localhost:6379", config.L2.Redis.Addr)
+	assert.Equal(t, 2, config.L2.Redis.DB)
+	assert.Equal(t, "market-fetcher:", config.L2.Redis.KeyPrefix)
+	assert.Equal(t, time.Second, config.L2.Redis.Timeout)
+	assert.False(t, DefaultCacheConfig().L2.Enabled())
+}
@@ -11,6 +11,21 @@ type GoCache struct {
 	cache *cache.Cache
 }
 
+// cachedItem is a value stored in go-cache together with its freshness information
+type cachedItem struct {
+	data     []byte
+	storedAt time.Time
+	staleAt  time.Time
+}
+
+func (c *cachedItem) toItem(now time.Time) Item {
+	return Item{
+		Data:     c.data,
+		StoredAt: c.storedAt,
+		Stale:    !c.staleAt.IsZero() && !now.Before(c.staleAt),
+	}
+}
+
 // NewGoCache creates a new GoCache instance
 // defaultExpiration: default expiration time for items
 // cleanupInterval: interval for cleaning up expired items
@@ -29,37 +44,69 @@ type GetResult struct {
 // Get retrieves values for the given keys
 // Returns found data and list of missing keys
 func (gc *GoCache) Get(keys []string) GetResult {
+	items, missingKeys := gc.GetItems(keys)
+
 	result := GetResult{
-		Found:       make(map[string][]byte),
-		MissingKeys: make([]string, 0),
+		Found:       make(map[string][]byte, len(items)),
+		MissingKeys: missingKeys,
+	}
+	for key, item := range items {
+		result.Found[key] = item.Data
 	}
 
+	return result
+}
+
+// GetItems retrieves values with freshness information for the given keys
+// Returns found items and list of missing keys
+func (gc *GoCache) GetItems(keys []string) (map[string]Item, []string) {
+	found := make(map[string]Item)
+	missingKeys := make([]string, 0)
+	now := time.Now()
+
 	for _, key := range keys {
-		if value, found := gc.cache.Get(key); found {
-			if data, ok := value.([]byte); ok {
-				result.Found[key] = data
-			} else {
-				// If stored value is not []byte, add to missing
-				result.MissingKeys = append(result.MissingKeys, key)
-			}
-		} else {
-			result.MissingKeys = append(result.MissingKeys, key)
+		value, exists := gc.cache.Get(key)
+		if !exists {
+			missingKeys = append(missingKeys, key)
+			continue
+		}
+
+		switch v := value.(type) {
+		case *cachedItem:
+			found[key] = v.toItem(now)
+		case []byte:
+			found[key] = Item{Data: v}
+		default:
+			// If stored value has unknown type, add to missing
+			missingKeys = append(missingKeys, key)
 		}
 	}
 
-	return result
+	return found, missingKeys
 }
 
 // Set stores key-value pairs with specified timeout
 // If timeout is 0, uses cache's default expiration
 // If timeout is -1 (cache.NoExpiration), item never expires
 func (gc *GoCache) Set(data map[string][]byte, timeout time.Duration) error {
+	return gc.SetWithStaleTime(data, time.Time{}, timeout)
+}
+
+// SetWithStaleTime stores key-value pairs which are considered stale after staleAt
+// and are removed after timeout. Zero staleAt means items never become stale.
+func (gc *GoCache) SetWithStaleTime(data map[string][]byte, staleAt time.Time, timeout time.Duration) error {
+	now := time.Now()
 	for key, value := range data {
-		gc.cache.Set(key, value, timeout)
+		gc.cache.Set(key, &cachedItem{data: value, storedAt: now, staleAt: staleAt}, timeout)
 	}
 	return nil
 }
 
+// setItem stores a single item keeping its original freshness information
+func (gc *GoCache) setItem(key string, item *cachedItem, timeout time.Duration) {
+	gc.cache.Set(key, item, timeout)
+}
+
 // Delete removes items from cache by keys
 func (gc *GoCache) Delete(keys []string) {
 	for _, key := range keys {
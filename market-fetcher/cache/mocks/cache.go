@@ -21,6 +21,7 @@ import (
 type MockICache struct {
 	ctrl     *gomock.Controller
 	recorder *MockICacheMockRecorder
+	isgomock struct{}
 }
 
 // MockICacheMockRecorder is the mock recorder for MockICache.
@@ -41,9 +42,9 @@ func (m *MockICache) EXPECT() *MockICacheMockRecorder {
 }
 
 // Get mocks base method.
-func (m *MockICache) Get(arg0 []string) (map[string][]byte, []string, error) {
+func (m *MockICache) Get(keys []string) (map[string][]byte, []string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", keys)
 	ret0, _ := ret[0].(map[string][]byte)
 	ret1, _ := ret[1].([]string)
 	ret2, _ := ret[2].(error)
@@ -51,36 +52,66 @@ func (m *MockICache) Get(arg0 []string) (map[string][]byte, []string, error) {
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockICacheMockRecorder) Get(arg0 any) *gomock.Call {
+func (mr *MockICacheMockRecorder) Get(keys any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockICache)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockICache)(nil).Get), keys)
+}
+
+// GetItems mocks base method.
+func (m *MockICache) GetItems(keys []string) (map[string]cache.Item, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", keys)
+	ret0, _ := ret[0].(map[string]cache.Item)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockICacheMockRecorder) GetItems(keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockICache)(nil).GetItems), keys)
 }
 
 // GetOrLoad mocks base method.
-func (m *MockICache) GetOrLoad(arg0 []string, arg1 cache.LoaderFunc, arg2 bool, arg3 time.Duration) (map[string][]byte, error) {
+func (m *MockICache) GetOrLoad(keys []string, loader cache.LoaderFunc, loadOnlyMissingKeys bool, ttl time.Duration) (map[string][]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrLoad", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetOrLoad", keys, loader, loadOnlyMissingKeys, ttl)
 	ret0, _ := ret[0].(map[string][]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOrLoad indicates an expected call of GetOrLoad.
-func (mr *MockICacheMockRecorder) GetOrLoad(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockICacheMockRecorder) GetOrLoad(keys, loader, loadOnlyMissingKeys, ttl any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrLoad", reflect.TypeOf((*MockICache)(nil).GetOrLoad), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrLoad", reflect.TypeOf((*MockICache)(nil).GetOrLoad), keys, loader, loadOnlyMissingKeys, ttl)
 }
 
 // Set mocks base method.
-func (m *MockICache) Set(arg0 map[string][]byte, arg1 time.Duration) error {
+func (m *MockICache) Set(data map[string][]byte, ttl time.Duration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Set", arg0, arg1)
+	ret := m.ctrl.Call(m, "Set", data, ttl)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockICacheMockRecorder) Set(arg0, arg1 any) *gomock.Call {
+func (mr *MockICacheMockRecorder) Set(data, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockICache)(nil).Set), data, ttl)
+}
+
+// SetWithSoftTTL mocks base method.
+func (m *MockICache) SetWithSoftTTL(data map[string][]byte, softTTL, hardTTL time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWithSoftTTL", data, softTTL, hardTTL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWithSoftTTL indicates an expected call of SetWithSoftTTL.
+func (mr *MockICacheMockRecorder) SetWithSoftTTL(data, softTTL, hardTTL any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockICache)(nil).Set), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithSoftTTL", reflect.TypeOf((*MockICache)(nil).SetWithSoftTTL), data, softTTL, hardTTL)
 }
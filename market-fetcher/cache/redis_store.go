@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore L2 store backed by any server speaking the Redis protocol
+type RedisStore struct {
+	client    *redis.Client
+	keyPrefix string
+	timeout   time.Duration
+}
+
+// NewRedisStore creates Redis store and verifies connectivity
+func NewRedisStore(config RedisConfig) (*RedisStore, error) {
+	if config.Addr == "" {
+		return nil, fmt.Errorf("redis store address is not configured")
+	}
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:         config.Addr,
+		Password:     config.Password,
+		DB:           config.DB,
+		DialTimeout:  timeout,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+	})
+
+	store := &RedisStore{
+		client:    client,
+		keyPrefix: config.KeyPrefix,
+		timeout:   timeout,
+	}
+
+	ctx, cancel := store.context()
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to redis at %s: %w", config.Addr, err)
+	}
+
+	return store, nil
+}
+
+func (s *RedisStore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
+
+func (s *RedisStore) key(key string) string {
+	return s.keyPrefix + key
+}
+
+// Get retrieves non-expired entries by keys using a single MGET
+func (s *RedisStore) Get(keys []string) (map[string]StoreEntry, []string, error) {
+	found := make(map[string]StoreEntry)
+	missing := make([]string, 0)
+	if len(keys) == 0 {
+		return found, missing, nil
+	}
+
+	redisKeys := make([]string, len(keys))
+	for i, key := range keys {
+		redisKeys[i] = s.key(key)
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+	values, err := s.client.MGet(ctx, redisKeys...).Result()
+	if err != nil {
+		return nil, keys, fmt.Errorf("redis store get failed: %w", err)
+	}
+
+	now := time.Now()
+	for i, key := range keys {
+		raw, ok := values[i].(string)
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		entry, err := decodeStoreEntry([]byte(raw))
+		if err != nil || entry.Expired(now) {
+			missing = append(missing, key)
+			continue
+		}
+		found[key] = entry
+	}
+
+	return found, missing, nil
+}
+
+// Set stores entries in a pipeline; expiration is enforced by Redis as well
+func (s *RedisStore) Set(entries map[string]StoreEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	ctx, cancel := s.context()
+	defer cancel()
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, entry := range entries {
+			var redisTTL time.Duration // 0 means no expiration
+			if !entry.ExpiresAt.IsZero() {
+				redisTTL = entry.ExpiresAt.Sub(now)
+				if redisTTL <= 0 {
+					continue
+				}
+			}
+			pipe.Set(ctx, s.key(key), encodeStoreEntry(entry), redisTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis store set failed: %w", err)
+	}
+	return nil
+}
+
+// Delete removes entries by keys
+func (s *RedisStore) Delete(keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	redisKeys := make([]string, len(keys))
+	for i, key := range keys {
+		redisKeys[i] = s.key(key)
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+	return s.client.Del(ctx, redisKeys...).Err()
+}
+
+// Close closes connection pool
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedisStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	store, err := NewRedisStore(RedisConfig{Addr: server.Addr(), KeyPrefix: "test:"})
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store, server
+}
+
+func TestRedisStore_SetGet(t *testing.T) {
+	store, server := newTestRedisStore(t)
+
+	err := store.Set(testEntries(map[string][]byte{"key1": []byte("value1")}, time.Minute))
+	require.NoError(t, err)
+
+	assert.True(t, server.Exists("test:key1"))
+	assert.InDelta(t, time.Minute, server.TTL("test:key1"), float64(time.Second))
+
+	found, missing, err := store.Get([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value1"), found["key1"].Data)
+	assert.Equal(t, []string{"key2"}, missing)
+}
+
+func TestRedisStore_Expiration(t *testing.T) {
+	store, server := newTestRedisStore(t)
+
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"key": []byte("value")}, time.Minute)))
+	server.FastForward(2 * time.Minute)
+
+	found, missing, err := store.Get([]string{"key"})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	assert.Equal(t, []string{"key"}, missing)
+}
+
+func TestRedisStore_NoExpiration(t *testing.T) {
+	store, server := newTestRedisStore(t)
+
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"key": []byte("value")}, NoExpiration)))
+	assert.Equal(t, time.Duration(0), server.TTL("test:key"))
+
+	found, _, err := store.Get([]string{"key"})
+	require.NoError(t, err)
+	assert.True(t, found["key"].ExpiresAt.IsZero())
+}
+
+func TestRedisStore_Delete(t *testing.T) {
+	store, server := newTestRedisStore(t)
+
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"key": []byte("value")}, time.Minute)))
+	require.NoError(t, store.Delete([]string{"key"}))
+	assert.False(t, server.Exists("test:key"))
+}
+
+func TestRedisStore_Unavailable(t *testing.T) {
+	_, err := NewRedisStore(RedisConfig{Addr: "127.0.0.1:1", Timeout: 100 * time.Millisecond})
+	assert.Error(t, err)
+}
@@ -3,13 +3,20 @@ package cache
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
+
+	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/scheduler"
 )
 
-// Service implements ICache interface with go-cache only
+// Service implements ICache interface with go-cache as L1 and optional persistent L2 store
 type Service struct {
-	goCache *GoCache
-	config  Config
+	goCache          *GoCache
+	store            IStore
+	config           Config
+	cleanupScheduler *scheduler.Scheduler
+	inflight         *inflightLoads
 }
 
 // NewService creates a new cache service with the given configuration
@@ -18,44 +25,85 @@ func NewService(config Config) *Service {
 	goCache := NewGoCache(config.GoCache.DefaultExpiration, config.GoCache.CleanupInterval)
 
 	return &Service{
-		goCache: goCache,
-		config:  config,
+		goCache:  goCache,
+		config:   config,
+		inflight: newInflightLoads(),
 	}
 }
 
+// NewServiceWithStore creates a new cache service backed by the given L2 store
+func NewServiceWithStore(config Config, store IStore) *Service {
+	service := NewService(config)
+	service.store = store
+	return service
+}
+
 // Start implements core.Interface
 func (s *Service) Start(ctx context.Context) error {
-	// ICache service doesn't need startup logic
-	// Just validate configuration
 	if s.goCache == nil {
 		return fmt.Errorf("cache service not properly initialized")
 	}
+
+	if s.store == nil && s.config.L2.Enabled() {
+		store, err := NewStore(s.config.L2)
+		if err != nil {
+			return fmt.Errorf("failed to initialize L2 cache: %w", err)
+		}
+		s.store = store
+		log.Printf("Cache: using %s L2 store", s.config.L2.Type)
+	}
+
+	// Redis expires keys itself, file based stores need periodic cleanup
+	if cleaner, ok := s.store.(interface{ DeleteExpired() (int, error) }); ok && s.config.GoCache.CleanupInterval > 0 {
+		s.cleanupScheduler = scheduler.New(s.config.GoCache.CleanupInterval, func(ctx context.Context) {
+			if _, err := cleaner.DeleteExpired(); err != nil {
+				log.Printf("Cache: failed to delete expired L2 entries: %v", err)
+			}
+		})
+		s.cleanupScheduler.Start(ctx, false)
+	}
+
 	return nil
 }
 
 // Stop implements core.Interface
 func (s *Service) Stop() {
-	// Clear and close caches
+	if s.cleanupScheduler != nil {
+		s.cleanupScheduler.Stop()
+	}
+
+	// Clear local cache and close L2 store, persisted data is kept
 	if s.goCache != nil {
 		s.goCache.Clear()
 	}
+	if s.store != nil {
+		if err := s.store.Close(); err != nil {
+			log.Printf("Cache: failed to close L2 store: %v", err)
+		}
+	}
 }
 
 // GetOrLoad retrieves data by keys from local cache or loads them using LoaderFunc
+// Stale keys are reloaded as well; if loading fails and no key is missing, stale data is returned.
+// Concurrent calls missing the same keys share a single loader call per key.
 func (s *Service) GetOrLoad(keys []string, loader LoaderFunc, loadOnlyMissingKeys bool, ttl time.Duration) (map[string][]byte, error) {
 	if len(keys) == 0 {
 		return make(map[string][]byte), nil
 	}
 
 	// Step 1: Get from local cache
-	result, missingKeys := s.getFromLocalCache(keys)
+	items, missingKeys := s.getItemsFromLocalCache(keys)
+	result := itemsData(items)
+	staleKeys := staleItemKeys(items)
 
-	// Step 2: Load missing data if needed
-	if len(missingKeys) > 0 {
-		keysToLoad := s.determineKeysToLoad(keys, missingKeys, loadOnlyMissingKeys)
-		loadedData, err := s.loadAndCacheLocal(keysToLoad, loader, ttl)
+	// Step 2: Load missing and stale data if needed
+	if len(missingKeys) > 0 || len(staleKeys) > 0 {
+		loadedData, err := s.loadCoalesced(keys, append(missingKeys, staleKeys...), loader, loadOnlyMissingKeys, ttl)
 		if err != nil {
-			return nil, err
+			if len(missingKeys) > 0 {
+				return nil, err
+			}
+			log.Printf("Cache: serving stale data, reload failed: %v", err)
 		}
 		s.mergeResults(result, loadedData)
 	}
@@ -64,22 +112,129 @@ func (s *Service) GetOrLoad(keys []string, loader LoaderFunc, loadOnlyMissingKey
 	return s.prepareFinalResult(keys, result, loadOnlyMissingKeys), nil
 }
 
-// getFromLocalCache retrieves data from go-cache only
-func (s *Service) getFromLocalCache(keys []string) (map[string][]byte, []string) {
-	l1Result := s.goCache.Get(keys)
-	return l1Result.Found, l1Result.MissingKeys
+// getItemsFromLocalCache retrieves items from go-cache, falling back to L2 store for missing keys
+func (s *Service) getItemsFromLocalCache(keys []string) (map[string]Item, []string) {
+	found, l1MissingKeys := s.goCache.GetItems(keys)
+	if s.store == nil || len(l1MissingKeys) == 0 {
+		return found, l1MissingKeys
+	}
+
+	l2Found, missingKeys, err := s.store.Get(l1MissingKeys)
+	if err != nil {
+		log.Printf("Cache: L2 get failed, serving from L1 only: %v", err)
+		return found, l1MissingKeys
+	}
+
+	// Read-through: populate L1 with remaining TTL and freshness of L2 entries
+	now := time.Now()
+	for key, entry := range l2Found {
+		ttl := entry.TTL(now)
+		if ttl == 0 {
+			missingKeys = append(missingKeys, key)
+			continue
+		}
+		item := &cachedItem{data: entry.Data, storedAt: entry.StoredAt, staleAt: entry.StaleAt}
+		s.goCache.setItem(key, item, ttl)
+		found[key] = item.toItem(now)
+	}
+
+	return found, missingKeys
+}
+
+// itemsData extracts data from items
+func itemsData(items map[string]Item) map[string][]byte {
+	data := make(map[string][]byte, len(items))
+	for key, item := range items {
+		data[key] = item.Data
+	}
+	return data
+}
+
+// staleItemKeys returns keys of stale items
+func staleItemKeys(items map[string]Item) []string {
+	var keys []string
+	for key, item := range items {
+		if item.Stale {
+			keys = append(keys, key)
+		}
+	}
+	return keys
 }
 
-// loadAndCacheLocal loads data using loader function and updates local cache only
+// loadCoalesced loads keys which are not being loaded by other callers and
+// waits for in-flight loads of the remaining keys
+func (s *Service) loadCoalesced(originalKeys, keysToRefresh []string, loader LoaderFunc, loadOnlyMissingKeys bool, ttl time.Duration) (map[string][]byte, error) {
+	call, leading, waiting := s.inflight.acquire(keysToRefresh)
+	result := make(map[string][]byte)
+	var loadErr error
+
+	if call != nil {
+		metrics.RecordCacheLoad()
+		loadedData, err := s.loadLeading(call, leading, originalKeys, loader, loadOnlyMissingKeys, ttl)
+		if err != nil {
+			loadErr = err
+		}
+		s.mergeResults(result, loadedData)
+	}
+
+	coalesced := false
+	for other, otherKeys := range waiting {
+		if containsAllKeys(result, otherKeys) {
+			continue
+		}
+		coalesced = true
+		<-other.done
+		if other.err != nil {
+			if loadErr == nil {
+				loadErr = other.err
+			}
+			continue
+		}
+		for _, key := range otherKeys {
+			if value, ok := other.data[key]; ok {
+				result[key] = value
+			}
+		}
+	}
+	if coalesced {
+		metrics.RecordCacheLoadCoalesced()
+	}
+
+	return result, loadErr
+}
+
+// loadLeading loads keys registered by the call and releases waiting callers,
+// even if the loader panics
+func (s *Service) loadLeading(call *loadCall, leading, originalKeys []string, loader LoaderFunc, loadOnlyMissingKeys bool, ttl time.Duration) (loadedData map[string][]byte, err error) {
+	err = fmt.Errorf("load of %d keys did not complete", len(leading))
+	defer func() {
+		s.inflight.release(call, leading, loadedData, err)
+	}()
+
+	keysToLoad := s.determineKeysToLoad(originalKeys, leading, loadOnlyMissingKeys)
+	return s.loadAndCacheLocal(keysToLoad, loader, ttl)
+}
+
+// containsAllKeys checks that data has values for all keys
+func containsAllKeys(data map[string][]byte, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := data[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// loadAndCacheLocal loads data using loader function and updates local cache and L2 store
 func (s *Service) loadAndCacheLocal(keysToLoad []string, loader LoaderFunc, ttl time.Duration) (map[string][]byte, error) {
 	loadedData, err := loader(keysToLoad)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load data: %w", err)
 	}
 
-	// Update local cache with loaded data
+	// Update caches with loaded data
 	if len(loadedData) > 0 {
-		if err := s.goCache.Set(loadedData, ttl); err != nil {
+		if err := s.Set(loadedData, ttl); err != nil {
 			return nil, fmt.Errorf("failed to cache data: %w", err)
 		}
 	}
@@ -87,6 +242,14 @@ func (s *Service) loadAndCacheLocal(keysToLoad []string, loader LoaderFunc, ttl
 	return loadedData, nil
 }
 
+// storeTTL resolves TTL for L2 store the same way go-cache does for L1
+func (s *Service) storeTTL(ttl time.Duration) time.Duration {
+	if ttl == 0 {
+		return s.config.GoCache.DefaultExpiration
+	}
+	return ttl
+}
+
 // mergeResults merges source map into destination map
 func (s *Service) mergeResults(dest, src map[string][]byte) {
 	for key, value := range src {
@@ -124,9 +287,14 @@ func (s *Service) Delete(keys []string) {
 	if s.goCache != nil {
 		s.goCache.Delete(keys)
 	}
+	if s.store != nil {
+		if err := s.store.Delete(keys); err != nil {
+			log.Printf("Cache: failed to delete keys from L2: %v", err)
+		}
+	}
 }
 
-// Clear removes all items from cache
+// Clear removes all items from local cache, L2 store is left intact
 func (s *Service) Clear() {
 	if s.goCache != nil {
 		s.goCache.Clear()
@@ -135,18 +303,64 @@ func (s *Service) Clear() {
 
 // Set stores data in cache with the specified TTL
 func (s *Service) Set(data map[string][]byte, ttl time.Duration) error {
+	return s.set(data, time.Time{}, ttl)
+}
+
+// SetWithSoftTTL stores data which becomes stale after softTTL and is removed after hardTTL
+func (s *Service) SetWithSoftTTL(data map[string][]byte, softTTL, hardTTL time.Duration) error {
+	softTTL = s.storeTTL(softTTL)
+	hardTTL = s.storeTTL(hardTTL)
+	if hardTTL >= 0 && hardTTL < softTTL {
+		hardTTL = softTTL
+	}
+
+	var staleAt time.Time
+	if softTTL > 0 {
+		staleAt = time.Now().Add(softTTL)
+	}
+	return s.set(data, staleAt, hardTTL)
+}
+
+// set stores data in L1 and writes it through to L2 store
+func (s *Service) set(data map[string][]byte, staleAt time.Time, ttl time.Duration) error {
 	if s.goCache == nil {
 		return fmt.Errorf("cache service not initialized")
 	}
-	return s.goCache.Set(data, ttl)
+	if err := s.goCache.SetWithStaleTime(data, staleAt, ttl); err != nil {
+		return err
+	}
+
+	// Write-through: L2 failures are not fatal since data is already in L1
+	if s.store != nil && len(data) > 0 {
+		now := time.Now()
+		expires := expiresAt(now, s.storeTTL(ttl))
+		entries := make(map[string]StoreEntry, len(data))
+		for key, value := range data {
+			entries[key] = StoreEntry{Data: value, StoredAt: now, StaleAt: staleAt, ExpiresAt: expires}
+		}
+		if err := s.store.Set(entries); err != nil {
+			log.Printf("Cache: L2 write-through failed: %v", err)
+		}
+	}
+	return nil
 }
 
-// Get retrieves data by keys from cache
+// Get retrieves data by keys from cache, stale data is returned as well
 func (s *Service) Get(keys []string) (map[string][]byte, []string, error) {
 	if s.goCache == nil {
 		return nil, keys, fmt.Errorf("cache service not initialized")
 	}
 
-	result := s.goCache.Get(keys)
-	return result.Found, result.MissingKeys, nil
+	items, missingKeys := s.getItemsFromLocalCache(keys)
+	return itemsData(items), missingKeys, nil
+}
+
+// GetItems retrieves data with freshness information by keys from cache
+func (s *Service) GetItems(keys []string) (map[string]Item, []string, error) {
+	if s.goCache == nil {
+		return nil, keys, fmt.Errorf("cache service not initialized")
+	}
+
+	items, missingKeys := s.getItemsFromLocalCache(keys)
+	return items, missingKeys, nil
 }
@@ -1,10 +1,15 @@
 package cache
 
 import (
+	"context"
 	"errors"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -192,3 +197,234 @@ func TestService_Implementation(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, data, 1)
 }
+
+func TestService_L2ReadThrough(t *testing.T) {
+	store := newTestBoltStore(t, filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, store.Set(testEntries(map[string][]byte{"key1": []byte("persisted")}, time.Hour)))
+
+	service := NewServiceWithStore(DefaultCacheConfig(), store)
+	defer service.Stop()
+
+	loader := func(missingKeys []string) (map[string][]byte, error) {
+		result := make(map[string][]byte)
+		for _, key := range missingKeys {
+			result[key] = []byte("loaded_" + key)
+		}
+		return result, nil
+	}
+
+	var requestedKeys []string
+	data, err := service.GetOrLoad([]string{"key1", "key2"}, func(keys []string) (map[string][]byte, error) {
+		requestedKeys = keys
+		return loader(keys)
+	}, true, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key2"}, requestedKeys)
+	assert.Equal(t, []byte("persisted"), data["key1"])
+
+	// L1 is populated from L2
+	assert.Equal(t, []byte("persisted"), service.goCache.Get([]string{"key1"}).Found["key1"])
+
+	// Loaded data is written through to L2
+	found, _, err := store.Get([]string{"key2"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("loaded_key2"), found["key2"].Data)
+}
+
+func TestService_L2WriteThroughAndRestart(t *testing.T) {
+	server := miniredis.RunT(t)
+	config := DefaultCacheConfig()
+	config.L2 = L2Config{Type: L2TypeRedis, Redis: RedisConfig{Addr: server.Addr()}}
+
+	service := NewService(config)
+	require.NoError(t, service.Start(context.Background()))
+	require.NoError(t, service.Set(map[string][]byte{"key": []byte("value")}, 0))
+	service.Stop()
+
+	// Default expiration is applied to L2 entries when TTL is 0
+	assert.InDelta(t, config.GoCache.DefaultExpiration, server.TTL("key"), float64(time.Second))
+
+	restarted := NewService(config)
+	require.NoError(t, restarted.Start(context.Background()))
+	defer restarted.Stop()
+
+	data, missing, err := restarted.Get([]string{"key", "other"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), data["key"])
+	assert.Equal(t, []string{"other"}, missing)
+
+	restarted.Delete([]string{"key"})
+	assert.False(t, server.Exists("key"))
+}
+
+func TestService_L2StartFailure(t *testing.T) {
+	config := DefaultCacheConfig()
+	config.L2 = L2Config{Type: "unknown"}
+
+	service := NewService(config)
+	assert.Error(t, service.Start(context.Background()))
+}
+
+func TestService_SoftTTL(t *testing.T) {
+	service := NewService(DefaultCacheConfig())
+
+	require.NoError(t, service.SetWithSoftTTL(map[string][]byte{"key": []byte("value")}, 20*time.Millisecond, time.Minute))
+
+	items, missing, err := service.GetItems([]string{"key"})
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.False(t, items["key"].Stale)
+
+	time.Sleep(30 * time.Millisecond)
+
+	// Stale data is still served until hard TTL expires
+	items, missing, err = service.GetItems([]string{"key"})
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.True(t, items["key"].Stale)
+	assert.Equal(t, []byte("value"), items["key"].Data)
+	assert.GreaterOrEqual(t, items["key"].Age(time.Now()), 20*time.Millisecond)
+
+	data, _, err := service.Get([]string{"key"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), data["key"])
+}
+
+func TestService_GetOrLoadStale(t *testing.T) {
+	service := NewService(DefaultCacheConfig())
+	require.NoError(t, service.SetWithSoftTTL(map[string][]byte{"key": []byte("old")}, time.Millisecond, time.Minute))
+	time.Sleep(5 * time.Millisecond)
+
+	// Loader failure falls back to stale data
+	data, err := service.GetOrLoad([]string{"key"}, func(keys []string) (map[string][]byte, error) {
+		return nil, errors.New("upstream down")
+	}, true, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("old"), data["key"])
+
+	// Successful reload replaces stale data
+	data, err = service.GetOrLoad([]string{"key"}, func(keys []string) (map[string][]byte, error) {
+		return map[string][]byte{"key": []byte("new")}, nil
+	}, true, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), data["key"])
+}
+
+func TestService_L2PreservesStaleness(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	store := newTestBoltStore(t, path)
+
+	service := NewServiceWithStore(DefaultCacheConfig(), store)
+	require.NoError(t, service.SetWithSoftTTL(map[string][]byte{"key": []byte("value")}, time.Millisecond, time.Minute))
+	time.Sleep(5 * time.Millisecond)
+
+	// Read from L2 only
+	service.Clear()
+	items, missing, err := service.GetItems([]string{"key"})
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.True(t, items["key"].Stale)
+	assert.False(t, items["key"].StoredAt.IsZero())
+	service.Stop()
+}
+
+func TestService_GetOrLoadCoalescesConcurrentMisses(t *testing.T) {
+	service := NewService(DefaultCacheConfig())
+
+	var loaderCalls atomic.Int32
+	release := make(chan struct{})
+	loader := func(keys []string) (map[string][]byte, error) {
+		loaderCalls.Add(1)
+		<-release
+		result := make(map[string][]byte)
+		for _, key := range keys {
+			result[key] = []byte("loaded_" + key)
+		}
+		return result, nil
+	}
+
+	// First caller starts loading key1 and key2
+	var wg sync.WaitGroup
+	results := make([]map[string][]byte, 5)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		data, err := service.GetOrLoad([]string{"key1", "key2"}, loader, true, time.Minute)
+		assert.NoError(t, err)
+		results[0] = data
+	}()
+	require.Eventually(t, func() bool { return loaderCalls.Load() == 1 }, time.Second, time.Millisecond)
+
+	// Concurrent callers with overlapping keys wait for the in-flight load
+	for i := 1; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			data, err := service.GetOrLoad([]string{"key2", "key1"}, loader, true, time.Minute)
+			assert.NoError(t, err)
+			results[i] = data
+		}(i)
+	}
+
+	// Caller with a new key loads only that key and waits for the rest
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		data, err := service.GetOrLoad([]string{"key1", "key3"}, loader, true, time.Minute)
+		assert.NoError(t, err)
+		results[4] = data
+	}()
+	require.Eventually(t, func() bool { return loaderCalls.Load() == 2 }, time.Second, time.Millisecond)
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(2), loaderCalls.Load())
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, []byte("loaded_key1"), results[i]["key1"])
+		assert.Equal(t, []byte("loaded_key2"), results[i]["key2"])
+	}
+	assert.Equal(t, []byte("loaded_key1"), results[4]["key1"])
+	assert.Equal(t, []byte("loaded_key3"), results[4]["key3"])
+}
+
+func TestService_GetOrLoadCoalescedError(t *testing.T) {
+	service := NewService(DefaultCacheConfig())
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	failingLoader := func(keys []string) (map[string][]byte, error) {
+		close(started)
+		<-release
+		return nil, errors.New("upstream down")
+	}
+
+	errs := make(chan error, 2)
+	go func() {
+		_, err := service.GetOrLoad([]string{"key"}, failingLoader, true, time.Minute)
+		errs <- err
+	}()
+	<-started
+
+	go func() {
+		_, err := service.GetOrLoad([]string{"key"}, func(keys []string) (map[string][]byte, error) {
+			t.Error("loader should not be called for in-flight key")
+			return nil, nil
+		}, true, time.Minute)
+		errs <- err
+	}()
+
+	// Give the second caller time to join the in-flight load
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	assert.Error(t, <-errs)
+	assert.Error(t, <-errs)
+
+	// Failed load is not remembered, next caller loads again
+	data, err := service.GetOrLoad([]string{"key"}, func(keys []string) (map[string][]byte, error) {
+		return map[string][]byte{"key": []byte("value")}, nil
+	}, true, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), data["key"])
+}
@@ -0,0 +1,66 @@
+package cache
+
+import "sync"
+
+// loadCall represents a loader call in progress or completed
+type loadCall struct {
+	done chan struct{}
+	data map[string][]byte
+	err  error
+}
+
+// inflightLoads tracks keys which are currently being loaded so that
+// concurrent misses for the same keys share a single loader call
+type inflightLoads struct {
+	sync.Mutex
+	calls map[string]*loadCall
+}
+
+func newInflightLoads() *inflightLoads {
+	return &inflightLoads{
+		calls: make(map[string]*loadCall),
+	}
+}
+
+// acquire registers a new call for keys which are not being loaded yet.
+// Returns the new call (nil if all keys are already being loaded), keys the caller
+// has to load, and calls in progress for the remaining keys.
+func (f *inflightLoads) acquire(keys []string) (*loadCall, []string, map[*loadCall][]string) {
+	f.Lock()
+	defer f.Unlock()
+
+	var call *loadCall
+	var leading []string
+	waiting := make(map[*loadCall][]string)
+
+	for _, key := range keys {
+		if existing, ok := f.calls[key]; ok {
+			if existing != call {
+				waiting[existing] = append(waiting[existing], key)
+			}
+			continue
+		}
+		if call == nil {
+			call = &loadCall{done: make(chan struct{})}
+		}
+		f.calls[key] = call
+		leading = append(leading, key)
+	}
+
+	return call, leading, waiting
+}
+
+// release stores the call result, unregisters its keys and wakes up waiting callers
+func (f *inflightLoads) release(call *loadCall, keys []string, data map[string][]byte, err error) {
+	f.Lock()
+	for _, key := range keys {
+		if f.calls[key] == call {
+			delete(f.calls, key)
+		}
+	}
+	f.Unlock()
+
+	call.data = data
+	call.err = err
+	close(call.done)
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// StoreEntry represents a value kept in a second-level store together with its expiration
+type StoreEntry struct {
+	Data      []byte
+	StoredAt  time.Time
+	StaleAt   time.Time // zero value means the entry never becomes stale
+	ExpiresAt time.Time // zero value means the entry never expires
+}
+
+// Expired reports whether the entry is expired at the given moment
+func (e StoreEntry) Expired(now time.Time) bool {
+	return !e.ExpiresAt.IsZero() && !now.Before(e.ExpiresAt)
+}
+
+// TTL returns remaining time to live of the entry, NoExpiration if the entry never expires
+// or 0 if it is already expired
+func (e StoreEntry) TTL(now time.Time) time.Duration {
+	if e.ExpiresAt.IsZero() {
+		return NoExpiration
+	}
+	if remaining := e.ExpiresAt.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// NoExpiration is passed as TTL to store items which never expire
+const NoExpiration time.Duration = -1
+
+// IStore interface for persistent second-level (L2) cache backends
+type IStore interface {
+	// Get retrieves non-expired entries by keys
+	// Returns found entries and list of missing keys
+	Get(keys []string) (map[string]StoreEntry, []string, error)
+
+	// Set stores entries
+	Set(entries map[string]StoreEntry) error
+
+	// Delete removes entries by keys
+	Delete(keys []string) error
+
+	// Close releases resources held by the store
+	Close() error
+}
+
+// NewStore creates L2 store for the given configuration
+// Returns nil store when L2 is disabled
+func NewStore(config L2Config) (IStore, error) {
+	switch config.Type {
+	case L2TypeNone:
+		return nil, nil
+	case L2TypeBolt:
+		store, err := NewBoltStore(config.Bolt)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case L2TypeRedis:
+		store, err := NewRedisStore(config.Redis)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown L2 cache type: %s", config.Type)
+	}
+}
+
+// expiresAt converts TTL to absolute expiration time, zero time means no expiration
+func expiresAt(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// storeEntryHeaderSize is the size of encoded timestamps preceding entry data
+const storeEntryHeaderSize = 24
+
+// encodeStoreEntry serializes entry as three 8-byte big-endian timestamps
+// (expiration, stale time and store time as unix nanos, 0 = unset) followed by data
+func encodeStoreEntry(entry StoreEntry) []byte {
+	buf := make([]byte, storeEntryHeaderSize+len(entry.Data))
+	putTime(buf[0:8], entry.ExpiresAt)
+	putTime(buf[8:16], entry.StaleAt)
+	putTime(buf[16:24], entry.StoredAt)
+	copy(buf[storeEntryHeaderSize:], entry.Data)
+	return buf
+}
+
+// decodeStoreEntry deserializes entry encoded by encodeStoreEntry
+func decodeStoreEntry(raw []byte) (StoreEntry, error) {
+	if len(raw) < storeEntryHeaderSize {
+		return StoreEntry{}, fmt.Errorf("invalid store entry: %d bytes", len(raw))
+	}
+
+	entry := StoreEntry{
+		Data:      make([]byte, len(raw)-storeEntryHeaderSize),
+		ExpiresAt: getTime(raw[0:8]),
+		StaleAt:   getTime(raw[8:16]),
+		StoredAt:  getTime(raw[16:24]),
+	}
+	copy(entry.Data, raw[storeEntryHeaderSize:])
+	return entry, nil
+}
+
+func putTime(buf []byte, t time.Time) {
+	var nanos int64
+	if !t.IsZero() {
+		nanos = t.UnixNano()
+	}
+	binary.BigEndian.PutUint64(buf, uint64(nanos))
+}
+
+func getTime(buf []byte) time.Time {
+	nanos := int64(binary.BigEndian.Uint64(buf))
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
@@ -0,0 +1,126 @@
+package clientauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// AnonymousConsumer is the name of the consumer of requests without credentials
+const AnonymousConsumer = "anonymous"
+
+// Config represents client authentication configuration
+type Config struct {
+	// Enabled turns on authentication of API requests
+	Enabled bool `yaml:"enabled"`
+
+	// ConsumersFile is a JSON file with consumers and their keys
+	ConsumersFile string `yaml:"consumers_file"`
+
+	// AllowAnonymous serves requests without credentials as the anonymous consumer
+	AllowAnonymous bool `yaml:"allow_anonymous"`
+
+	// AnonymousQuota is the quota shared by all anonymous requests
+	AnonymousQuota Quota `yaml:"anonymous_quota"`
+
+	// DefaultQuota is used by consumers without their own quota
+	DefaultQuota Quota `yaml:"default_quota"`
+
+	// Consumers loaded from ConsumersFile
+	Consumers []Consumer `yaml:"-"`
+}
+
+// Quota is a token bucket: RequestsPerMinute refill rate and Burst capacity
+type Quota struct {
+	RequestsPerMinute int `yaml:"requests_per_minute" json:"requests_per_minute"` // 0 means unlimited
+	Burst             int `yaml:"burst" json:"burst"`                             // defaults to RequestsPerMinute
+}
+
+// Unlimited returns true if the quota doesn't limit requests
+func (q Quota) Unlimited() bool {
+	return q.RequestsPerMinute <= 0
+}
+
+// GetBurst returns the bucket capacity or default value
+func (q Quota) GetBurst() int {
+	if q.Burst > 0 {
+		return q.Burst
+	}
+
+	return q.RequestsPerMinute
+}
+
+// Consumer is an API client identified by one of its keys
+type Consumer struct {
+	Name  string   `json:"name"`
+	Keys  []string `json:"keys"`
+	Quota *Quota   `json:"quota,omitempty"` // nil uses the default quota
+}
+
+// consumersFile is the format of the consumers file
+type consumersFile struct {
+	Consumers []Consumer `json:"consumers"`
+}
+
+// LoadConsumers loads and validates consumers from ConsumersFile if authentication is enabled
+func (c *Config) LoadConsumers() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	data, err := os.ReadFile(c.ConsumersFile)
+	if err != nil {
+		return fmt.Errorf("error reading consumers file: %w", err)
+	}
+
+	var file consumersFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("error parsing consumers file: %w", err)
+	}
+
+	c.Consumers = file.Consumers
+	return c.Validate()
+}
+
+// Validate checks that consumers have unique names and keys
+func (c *Config) Validate() error {
+	names := make(map[string]bool, len(c.Consumers))
+	keys := make(map[string]string)
+
+	for i, consumer := range c.Consumers {
+		if consumer.Name == "" {
+			return fmt.Errorf("consumer at index %d: name cannot be empty", i)
+		}
+		if consumer.Name == AnonymousConsumer {
+			return fmt.Errorf("consumer '%s': name is reserved", consumer.Name)
+		}
+		if names[consumer.Name] {
+			return fmt.Errorf("consumer '%s': name must be unique", consumer.Name)
+		}
+		names[consumer.Name] = true
+
+		if len(consumer.Keys) == 0 {
+			return fmt.Errorf("consumer '%s': at least one key must be configured", consumer.Name)
+		}
+		for _, key := range consumer.Keys {
+			if key == "" {
+				return fmt.Errorf("consumer '%s': key cannot be empty", consumer.Name)
+			}
+			if owner, exists := keys[key]; exists {
+				return fmt.Errorf("consumer '%s': key is already used by consumer '%s'", consumer.Name, owner)
+			}
+			keys[key] = consumer.Name
+		}
+	}
+
+	return nil
+}
+
+// quotaOf returns the quota of the consumer
+func (c *Config) quotaOf(consumer Consumer) Quota {
+	if consumer.Quota != nil {
+		return *consumer.Quota
+	}
+
+	return c.DefaultQuota
+}
@@ -0,0 +1,79 @@
+package clientauth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConsumersFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "consumers.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestConfig_LoadConsumers(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "valid consumers",
+			content: `{"consumers": [{"name": "wallet", "keys": ["k1", "k2"], "quota": {"requests_per_minute": 60}}, {"name": "bot", "keys": ["k3"]}]}`,
+		},
+		{
+			name:    "invalid json",
+			content: `{"consumers": [`,
+			wantErr: "error parsing consumers file",
+		},
+		{
+			name:    "duplicate name",
+			content: `{"consumers": [{"name": "wallet", "keys": ["k1"]}, {"name": "wallet", "keys": ["k2"]}]}`,
+			wantErr: "name must be unique",
+		},
+		{
+			name:    "duplicate key",
+			content: `{"consumers": [{"name": "wallet", "keys": ["k1"]}, {"name": "bot", "keys": ["k1"]}]}`,
+			wantErr: "already used by consumer 'wallet'",
+		},
+		{
+			name:    "no keys",
+			content: `{"consumers": [{"name": "wallet", "keys": []}]}`,
+			wantErr: "at least one key",
+		},
+		{
+			name:    "reserved name",
+			content: `{"consumers": [{"name": "anonymous", "keys": ["k1"]}]}`,
+			wantErr: "name is reserved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Enabled: true, ConsumersFile: writeConsumersFile(t, tt.content)}
+			err := cfg.LoadConsumers()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, cfg.Consumers, 2)
+			assert.Equal(t, 60, cfg.quotaOf(cfg.Consumers[0]).RequestsPerMinute)
+		})
+	}
+
+	t.Run("disabled auth does not read the file", func(t *testing.T) {
+		cfg := Config{ConsumersFile: "missing.json"}
+		assert.NoError(t, cfg.LoadConsumers())
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		cfg := Config{Enabled: true, ConsumersFile: filepath.Join(t.TempDir(), "missing.json")}
+		assert.Error(t, cfg.LoadConsumers())
+	})
+}
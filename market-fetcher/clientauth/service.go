@@ -0,0 +1,195 @@
+package clientauth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/status-im/market-proxy/metrics"
+)
+
+var (
+	errMissingCredentials = errors.New("missing")
+	errInvalidCredentials = errors.New("invalid")
+)
+
+// publicPaths are served without authentication
+var publicPaths = map[string]bool{
+	"/health":  true,
+	"/metrics": true,
+}
+
+type contextKey struct{}
+
+// WithConsumer returns a copy of ctx carrying the consumer name
+func WithConsumer(ctx context.Context, consumer string) context.Context {
+	return context.WithValue(ctx, contextKey{}, consumer)
+}
+
+// ConsumerFromContext returns the name of the consumer the request was authenticated as
+func ConsumerFromContext(ctx context.Context) (string, bool) {
+	consumer, ok := ctx.Value(contextKey{}).(string)
+	return consumer, ok
+}
+
+// bucket is a token bucket of a consumer
+type bucket struct {
+	quota   Quota
+	limiter *rate.Limiter
+}
+
+func newBucket(quota Quota) *bucket {
+	return &bucket{
+		quota:   quota,
+		limiter: rate.NewLimiter(rate.Limit(float64(quota.RequestsPerMinute)/60), quota.GetBurst()),
+	}
+}
+
+// Service authenticates API clients by their keys and enforces per-consumer quotas
+type Service struct {
+	state struct {
+		sync.RWMutex
+		config  Config
+		byKey   map[string]string  // key -> consumer name
+		buckets map[string]*bucket // consumer name -> bucket, nil for unlimited consumers
+	}
+}
+
+// NewService creates a new client authentication service
+func NewService(config Config) *Service {
+	service := &Service{}
+	service.SetConfig(config)
+	return service
+}
+
+// SetConfig replaces consumers and quotas, buckets of consumers with unchanged quotas are kept
+func (s *Service) SetConfig(config Config) {
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	byKey := make(map[string]string)
+	quotas := map[string]Quota{AnonymousConsumer: config.AnonymousQuota}
+	for _, consumer := range config.Consumers {
+		for _, key := range consumer.Keys {
+			byKey[key] = consumer.Name
+		}
+		quotas[consumer.Name] = config.quotaOf(consumer)
+	}
+
+	buckets := make(map[string]*bucket, len(quotas))
+	for name, quota := range quotas {
+		if quota.Unlimited() {
+			continue
+		}
+		if existing := s.state.buckets[name]; existing != nil && existing.quota == quota {
+			buckets[name] = existing
+		} else {
+			buckets[name] = newBucket(quota)
+		}
+	}
+
+	s.state.config = config
+	s.state.byKey = byKey
+	s.state.buckets = buckets
+
+	if config.Enabled {
+		log.Printf("Client auth: %d consumers configured (anonymous access: %v)", len(config.Consumers), config.AllowAnonymous)
+	}
+}
+
+// Start implements core.Interface
+func (s *Service) Start(ctx context.Context) error {
+	return nil
+}
+
+// Stop implements core.Interface
+func (s *Service) Stop() {}
+
+// Middleware authenticates requests, enforces quotas and attaches the consumer to the request context.
+// Health and metrics endpoints are served without authentication.
+func (s *Service) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.state.RLock()
+		enabled := s.state.config.Enabled
+		s.state.RUnlock()
+
+		if !enabled || publicPaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		consumer, err := s.authenticate(r)
+		if err != nil {
+			metrics.RecordClientAuthFailure(err.Error())
+			w.Header().Set("WWW-Authenticate", `Bearer realm="market-proxy"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		if !s.allow(w, consumer, time.Now()) {
+			metrics.RecordConsumerRequest(consumer, "rate_limited")
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+			return
+		}
+
+		metrics.RecordConsumerRequest(consumer, "allowed")
+		next.ServeHTTP(w, r.WithContext(WithConsumer(r.Context(), consumer)))
+	})
+}
+
+// authenticate returns the consumer of the key passed as bearer token or in X-API-Key header
+func (s *Service) authenticate(r *http.Request) (string, error) {
+	key := r.Header.Get("X-API-Key")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		key = strings.TrimPrefix(auth, "Bearer ")
+	}
+
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if key == "" {
+		if s.state.config.AllowAnonymous {
+			return AnonymousConsumer, nil
+		}
+		return "", errMissingCredentials
+	}
+
+	consumer, exists := s.state.byKey[key]
+	if !exists {
+		return "", errInvalidCredentials
+	}
+	return consumer, nil
+}
+
+// allow takes a token from the consumer bucket and sets X-RateLimit-* headers
+func (s *Service) allow(w http.ResponseWriter, consumer string, now time.Time) bool {
+	s.state.RLock()
+	b := s.state.buckets[consumer]
+	s.state.RUnlock()
+
+	if b == nil {
+		return true
+	}
+
+	allowed := b.limiter.AllowN(now, 1)
+	tokens := b.limiter.TokensAt(now)
+	perSecond := float64(b.quota.RequestsPerMinute) / 60
+
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(b.quota.RequestsPerMinute))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(int(math.Max(0, math.Floor(tokens)))))
+	// Seconds until the bucket is full again
+	w.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil((float64(b.quota.GetBurst())-tokens)/perSecond))))
+
+	if !allowed {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil((1-tokens)/perSecond))))
+	}
+	return allowed
+}
@@ -0,0 +1,124 @@
+package clientauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestConfig() Config {
+	return Config{
+		Enabled:      true,
+		DefaultQuota: Quota{RequestsPerMinute: 60, Burst: 2},
+		Consumers: []Consumer{
+			{Name: "wallet", Keys: []string{"wallet-key"}},
+			{Name: "unlimited", Keys: []string{"unlimited-key"}, Quota: &Quota{}},
+		},
+	}
+}
+
+// serve passes a request through the middleware and returns the response and the consumer seen by the handler
+func serve(service *Service, path string, headers map[string]string) (*httptest.ResponseRecorder, string) {
+	var consumer string
+	handler := service.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		consumer, _ = ConsumerFromContext(r.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder, consumer
+}
+
+func TestService_Authentication(t *testing.T) {
+	service := NewService(createTestConfig())
+
+	recorder, _ := serve(service, "/api/v1/simple/price", nil)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder, _ = serve(service, "/api/v1/simple/price", map[string]string{"Authorization": "Bearer wrong"})
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder, consumer := serve(service, "/api/v1/simple/price", map[string]string{"Authorization": "Bearer wallet-key"})
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "wallet", consumer)
+
+	recorder, consumer = serve(service, "/api/v1/simple/price", map[string]string{"X-API-Key": "unlimited-key"})
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "unlimited", consumer)
+	assert.Empty(t, recorder.Header().Get("X-RateLimit-Limit"))
+
+	// Health and metrics are public
+	recorder, consumer = serve(service, "/health", nil)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, consumer)
+}
+
+func TestService_Anonymous(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.AllowAnonymous = true
+	service := NewService(cfg)
+
+	recorder, consumer := serve(service, "/api/v1/simple/price", nil)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, AnonymousConsumer, consumer)
+
+	// Invalid keys are rejected even if anonymous access is allowed
+	recorder, _ = serve(service, "/api/v1/simple/price", map[string]string{"X-API-Key": "wrong"})
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestService_Disabled(t *testing.T) {
+	service := NewService(Config{})
+
+	recorder, consumer := serve(service, "/api/v1/simple/price", nil)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, consumer)
+}
+
+func TestService_Quota(t *testing.T) {
+	service := NewService(createTestConfig())
+	headers := map[string]string{"Authorization": "Bearer wallet-key"}
+
+	recorder, _ := serve(service, "/api/v1/simple/price", headers)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "60", recorder.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "1", recorder.Header().Get("X-RateLimit-Remaining"))
+
+	recorder, _ = serve(service, "/api/v1/simple/price", headers)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "0", recorder.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "2", recorder.Header().Get("X-RateLimit-Reset"))
+
+	recorder, _ = serve(service, "/api/v1/simple/price", headers)
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "1", recorder.Header().Get("Retry-After"))
+}
+
+func TestService_SetConfig(t *testing.T) {
+	service := NewService(createTestConfig())
+	now := time.Now()
+	assert.True(t, service.allow(httptest.NewRecorder(), "wallet", now))
+	assert.True(t, service.allow(httptest.NewRecorder(), "wallet", now))
+	assert.False(t, service.allow(httptest.NewRecorder(), "wallet", now))
+
+	// Bucket of a consumer with unchanged quota is kept
+	service.SetConfig(createTestConfig())
+	assert.False(t, service.allow(httptest.NewRecorder(), "wallet", now))
+
+	// Changed quota starts with a full bucket, removed keys are rejected
+	cfg := createTestConfig()
+	cfg.DefaultQuota.Burst = 3
+	cfg.Consumers[0].Keys = []string{"rotated-key"}
+	service.SetConfig(cfg)
+	assert.True(t, service.allow(httptest.NewRecorder(), "wallet", now))
+
+	recorder, _ := serve(service, "/api/v1/simple/price", map[string]string{"Authorization": "Bearer wallet-key"})
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
@@ -57,7 +57,7 @@ func (c *CoinGeckoClient) FetchAssetsPlatforms(params AssetsPlatformsParams) (As
 
 		c.successfulFetch.Store(true)
 
-		var result interface{}
+		var result AssetsPlatformsResponse
 		if err := json.Unmarshal(body, &result); err != nil {
 			log.Printf("CoinGecko-AssetsPlatforms: Error parsing JSON response: %v", err)
 			return nil, false, err
@@ -2,42 +2,223 @@ package coingecko_assets_platforms
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/scheduler"
 )
 
+// cachedFilters are `filter` values whose lists are refreshed together with the full list
+var cachedFilters = []string{"nft"}
+
 type IAPIClient interface {
 	FetchAssetsPlatforms(params AssetsPlatformsParams) (AssetsPlatformsResponse, error)
 	Healthy() bool
 }
 
+// Service periodically fetches the asset platforms list and indexes it by ID and chain ID
 type Service struct {
-	config *config.Config
-	client IAPIClient
+	config        *config.Config
+	client        IAPIClient
+	metricsWriter *metrics.MetricsWriter
+	scheduler     *scheduler.Scheduler
+	platforms     struct {
+		sync.RWMutex
+		list      AssetsPlatformsResponse
+		filtered  map[string]AssetsPlatformsResponse
+		byID      map[string]AssetPlatform
+		byChainID map[int64]AssetPlatform
+		updatedAt time.Time
+	}
 }
 
 func NewService(config *config.Config) *Service {
 	client := NewCoinGeckoClient(config)
 	return &Service{
-		config: config,
-		client: client,
+		config:        config,
+		client:        client,
+		metricsWriter: metrics.NewMetricsWriter(metrics.ServicePlatforms),
 	}
 }
 
+// Start implements core.Interface
 func (s *Service) Start(ctx context.Context) error {
+	s.scheduler = scheduler.New(s.config.CoingeckoPlatforms.GetUpdateInterval(), s.update)
+	s.scheduler.Start(ctx, true)
 	return nil
 }
 
+// Stop implements core.Interface
 func (s *Service) Stop() {
+	if s.scheduler != nil {
+		s.scheduler.Stop()
+	}
+}
+
+// ApplyConfig implements core.IReloadable, the update interval is changed live
+func (s *Service) ApplyConfig(cfg *config.Config) {
+	if s.scheduler != nil {
+		s.scheduler.SetInterval(cfg.CoingeckoPlatforms.GetUpdateInterval())
+	}
+}
+
+// update fetches the asset platforms list and lists of cached filters, previous lists are kept if fetching fails
+func (s *Service) update(ctx context.Context) {
+	s.metricsWriter.ResetCycleMetrics()
+	defer s.metricsWriter.TrackDataFetchCycle()()
+
+	platforms, err := s.client.FetchAssetsPlatforms(AssetsPlatformsParams{})
+	if err != nil {
+		log.Printf("Failed to fetch asset platforms: %v", err)
+		return
+	}
+
+	if len(platforms) == 0 {
+		log.Printf("Asset platforms response is empty, keeping previous list")
+		return
+	}
+
+	platforms, decoded := s.enrichChainIDs(platforms)
+
+	byID := make(map[string]AssetPlatform, len(decoded))
+	byChainID := make(map[int64]AssetPlatform, len(decoded))
+	for _, platform := range decoded {
+		byID[platform.ID] = platform
+		if platform.ChainIdentifier != nil {
+			byChainID[*platform.ChainIdentifier] = platform
+		}
+	}
+
+	filtered := make(map[string]AssetsPlatformsResponse, len(cachedFilters))
+	for _, filter := range cachedFilters {
+		list, err := s.client.FetchAssetsPlatforms(AssetsPlatformsParams{Filter: filter})
+		if err != nil {
+			log.Printf("Failed to fetch asset platforms with filter %s: %v", filter, err)
+			continue
+		}
+		filtered[filter], _ = s.enrichChainIDs(list)
+	}
+
+	s.platforms.Lock()
+	s.platforms.list = platforms
+	s.platforms.byID = byID
+	s.platforms.byChainID = byChainID
+	// Previous filtered lists are kept if fetching them fails
+	if s.platforms.filtered == nil {
+		s.platforms.filtered = make(map[string]AssetsPlatformsResponse, len(cachedFilters))
+	}
+	for filter, list := range filtered {
+		s.platforms.filtered[filter] = list
+	}
+	s.platforms.updatedAt = time.Now()
+	s.platforms.Unlock()
+
+	s.metricsWriter.RecordCacheSize(len(platforms))
+	log.Printf("Asset platforms update complete: %d platforms, %d with chain ID", len(platforms), len(byChainID))
 }
 
+// enrichChainIDs decodes lookup fields of platforms and sets chain IDs from config for platforms
+// which have no chain_identifier upstream. Items which can't be decoded are skipped in lookups.
+func (s *Service) enrichChainIDs(platforms AssetsPlatformsResponse) (AssetsPlatformsResponse, []AssetPlatform) {
+	chainIDs := s.config.CoingeckoPlatforms.ChainIDs
+
+	decoded := make([]AssetPlatform, 0, len(platforms))
+	for i, item := range platforms {
+		var platform AssetPlatform
+		if err := json.Unmarshal(item, &platform); err != nil {
+			log.Printf("Failed to decode asset platform: %v", err)
+			continue
+		}
+
+		if chainID, ok := chainIDs[platform.ID]; ok && platform.ChainIdentifier == nil {
+			if enriched, err := setChainIdentifier(item, chainID); err == nil {
+				platforms[i] = enriched
+				platform.ChainIdentifier = &chainID
+			} else {
+				log.Printf("Failed to set chain ID of asset platform %s: %v", platform.ID, err)
+			}
+		}
+
+		decoded = append(decoded, platform)
+	}
+
+	return platforms, decoded
+}
+
+// setChainIdentifier returns the raw platform item with chain_identifier set, other fields are kept
+func setChainIdentifier(item json.RawMessage, chainID int64) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(item, &fields); err != nil {
+		return nil, err
+	}
+
+	value, err := json.Marshal(chainID)
+	if err != nil {
+		return nil, err
+	}
+	fields["chain_identifier"] = value
+
+	return json.Marshal(fields)
+}
+
+// AssetsPlatforms returns the cached asset platforms list or the cached list of a filter.
+// Other filters are fetched from upstream, as well as all lists until the first update completes.
 func (s *Service) AssetsPlatforms(params AssetsPlatformsParams) (AssetsPlatformsResponse, error) {
-	return s.client.FetchAssetsPlatforms(params)
+	s.platforms.RLock()
+	list := s.platforms.list
+	if params.Filter != "" {
+		list = s.platforms.filtered[params.Filter]
+	}
+	s.platforms.RUnlock()
+
+	if list != nil {
+		return list, nil
+	}
+
+	platforms, err := s.client.FetchAssetsPlatforms(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch asset platforms: %w", err)
+	}
+
+	platforms, _ = s.enrichChainIDs(platforms)
+	return platforms, nil
+}
+
+// GetPlatformByID returns the asset platform with the given CoinGecko platform ID
+func (s *Service) GetPlatformByID(id string) (AssetPlatform, bool) {
+	s.platforms.RLock()
+	defer s.platforms.RUnlock()
+
+	platform, ok := s.platforms.byID[id]
+	return platform, ok
+}
+
+// GetPlatformByChainID returns the asset platform with the given EVM chain ID
+func (s *Service) GetPlatformByChainID(chainID int64) (AssetPlatform, bool) {
+	s.platforms.RLock()
+	defer s.platforms.RUnlock()
+
+	platform, ok := s.platforms.byChainID[chainID]
+	return platform, ok
+}
+
+// UpdatedAt returns the time of the last successful update, zero if not updated yet
+func (s *Service) UpdatedAt() time.Time {
+	s.platforms.RLock()
+	defer s.platforms.RUnlock()
+
+	return s.platforms.updatedAt
 }
 
+// Healthy checks if the asset platforms list is available
 func (s *Service) Healthy() bool {
-	if s.client != nil {
-		return s.client.Healthy()
-	}
-	return false
+	s.platforms.RLock()
+	defer s.platforms.RUnlock()
+
+	return len(s.platforms.list) > 0
 }
@@ -2,9 +2,12 @@ package coingecko_assets_platforms
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/status-im/market-proxy/config"
 )
 
@@ -12,13 +15,20 @@ type MockIAPIClient struct {
 	shouldFail      bool
 	shouldBeHealthy bool
 	response        AssetsPlatformsResponse
+	filtered        map[string]AssetsPlatformsResponse
+	calls           int
 }
 
 func (m *MockIAPIClient) FetchAssetsPlatforms(params AssetsPlatformsParams) (AssetsPlatformsResponse, error) {
+	m.calls++
 	if m.shouldFail {
 		return nil, fmt.Errorf("mock error")
 	}
-	return m.response, nil
+	if params.Filter != "" {
+		return append(AssetsPlatformsResponse(nil), m.filtered[params.Filter]...), nil
+	}
+	// Copy as the service enriches items in place
+	return append(AssetsPlatformsResponse(nil), m.response...), nil
 }
 
 func (m *MockIAPIClient) Healthy() bool {
@@ -50,9 +60,26 @@ func TestNewService(t *testing.T) {
 	}
 }
 
+var testPlatforms = AssetsPlatformsResponse{
+	json.RawMessage(`{"id":"ethereum","chain_identifier":1,"name":"Ethereum","shortname":"Ethereum","native_coin_id":"ethereum"}`),
+	json.RawMessage(`{"id":"polygon-pos","chain_identifier":137,"name":"Polygon POS","shortname":"MATIC"}`),
+	json.RawMessage(`{"id":"status","chain_identifier":null,"name":"Status Network"}`),
+	json.RawMessage(`{"id":"solana","chain_identifier":null,"name":"Solana"}`),
+}
+
+var testNftPlatforms = AssetsPlatformsResponse{
+	json.RawMessage(`{"id":"ethereum","chain_identifier":1,"name":"Ethereum"}`),
+}
+
+func createTestService(client IAPIClient) *Service {
+	service := NewService(createTestConfig())
+	service.config.CoingeckoPlatforms.ChainIDs = map[string]int64{"status": 1660990954}
+	service.client = client
+	return service
+}
+
 func TestService_StartStop(t *testing.T) {
-	config := createTestConfig()
-	service := NewService(config)
+	service := createTestService(&MockIAPIClient{response: testPlatforms})
 
 	// Test starting
 	err := service.Start(context.Background())
@@ -66,61 +93,102 @@ func TestService_StartStop(t *testing.T) {
 }
 
 func TestService_AssetsPlatforms(t *testing.T) {
-	config := createTestConfig()
-	service := NewService(config)
+	mockClient := &MockIAPIClient{response: testPlatforms}
+	service := createTestService(mockClient)
 
-	// Mock successful response
-	mockData := []interface{}{
-		map[string]interface{}{"id": "ethereum", "name": "Ethereum"},
-		map[string]interface{}{"id": "polygon-pos", "name": "Polygon"},
-	}
+	// Before the first update the list is fetched from upstream
+	result, err := service.AssetsPlatforms(AssetsPlatformsParams{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 4)
 
-	mockClient := &MockIAPIClient{
-		shouldFail:      false,
-		shouldBeHealthy: true,
-		response:        mockData,
-	}
-	service.client = mockClient
+	service.update(context.Background())
+	assert.True(t, service.Healthy())
+	assert.False(t, service.UpdatedAt().IsZero())
 
-	// Test successful call
-	result, err := service.AssetsPlatforms(AssetsPlatformsParams{Filter: "nft"})
-	if err != nil {
-		t.Fatalf("AssetsPlatforms failed: %v", err)
-	}
+	// Cached list is served when upstream fails
+	mockClient.shouldFail = true
+	result, err = service.AssetsPlatforms(AssetsPlatformsParams{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 4)
 
-	if result == nil {
-		t.Error("Result should not be nil")
-	}
+	// Lists of not cached filters are fetched from upstream
+	_, err = service.AssetsPlatforms(AssetsPlatformsParams{Filter: "unknown"})
+	assert.Error(t, err)
+
+	// Failed update keeps previous list
+	service.update(context.Background())
+	assert.True(t, service.Healthy())
+}
+
+func TestService_AssetsPlatformsKeepsUpstreamFields(t *testing.T) {
+	service := createTestService(&MockIAPIClient{response: testPlatforms})
+	service.update(context.Background())
+
+	result, err := service.AssetsPlatforms(AssetsPlatformsParams{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(testPlatforms[0]), string(result[0]))
+
+	// Chain ID from config is added to the upstream item
+	assert.JSONEq(t, `{"id":"status","chain_identifier":1660990954,"name":"Status Network"}`, string(result[2]))
+}
+
+func TestService_AssetsPlatformsFilterCached(t *testing.T) {
+	mockClient := &MockIAPIClient{response: testPlatforms, filtered: map[string]AssetsPlatformsResponse{"nft": testNftPlatforms}}
+	service := createTestService(mockClient)
+	service.update(context.Background())
+	assert.Equal(t, 1+len(cachedFilters), mockClient.calls)
 
-	// Test with API error
 	mockClient.shouldFail = true
-	_, err = service.AssetsPlatforms(AssetsPlatformsParams{})
-	if err == nil {
-		t.Error("AssetsPlatforms should fail when API fails")
-	}
+	result, err := service.AssetsPlatforms(AssetsPlatformsParams{Filter: "nft"})
+	assert.NoError(t, err)
+	assert.Equal(t, testNftPlatforms, result)
+
+	// Failed update keeps previous filtered list
+	service.update(context.Background())
+	result, err = service.AssetsPlatforms(AssetsPlatformsParams{Filter: "nft"})
+	assert.NoError(t, err)
+	assert.Equal(t, testNftPlatforms, result)
 }
 
-func TestService_Healthy(t *testing.T) {
-	config := createTestConfig()
-	service := NewService(config)
+func TestService_Lookup(t *testing.T) {
+	service := createTestService(&MockIAPIClient{response: testPlatforms})
 
-	// Mock healthy client
-	mockClient := &MockIAPIClient{shouldBeHealthy: true}
-	service.client = mockClient
+	_, ok := service.GetPlatformByID("ethereum")
+	assert.False(t, ok)
 
-	if !service.Healthy() {
-		t.Error("Service should be healthy when client is healthy")
-	}
+	service.update(context.Background())
+
+	platform, ok := service.GetPlatformByID("polygon-pos")
+	assert.True(t, ok)
+	assert.Equal(t, int64(137), *platform.ChainIdentifier)
+
+	platform, ok = service.GetPlatformByChainID(1)
+	assert.True(t, ok)
+	assert.Equal(t, "ethereum", platform.ID)
+
+	// Chain ID from config enriches platforms without chain_identifier
+	platform, ok = service.GetPlatformByChainID(1660990954)
+	assert.True(t, ok)
+	assert.Equal(t, "status", platform.ID)
+
+	platform, ok = service.GetPlatformByID("solana")
+	assert.True(t, ok)
+	assert.Nil(t, platform.ChainIdentifier)
+
+	_, ok = service.GetPlatformByChainID(999)
+	assert.False(t, ok)
+}
+
+func TestService_Healthy(t *testing.T) {
+	mockClient := &MockIAPIClient{shouldBeHealthy: true, response: testPlatforms}
+	service := createTestService(mockClient)
 
-	// Mock unhealthy client
-	mockClient.shouldBeHealthy = false
 	if service.Healthy() {
-		t.Error("Service should not be healthy when client is unhealthy")
+		t.Error("Service should not be healthy before the first update")
 	}
 
-	// Test with nil client
-	service.client = nil
-	if service.Healthy() {
-		t.Error("Service should not be healthy when client is nil")
+	service.update(context.Background())
+	if !service.Healthy() {
+		t.Error("Service should be healthy when platforms are cached")
 	}
 }
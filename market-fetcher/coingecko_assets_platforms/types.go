@@ -1,7 +1,18 @@
 package coingecko_assets_platforms
 
+import "encoding/json"
+
 type AssetsPlatformsParams struct {
 	Filter string `json:"filter,omitempty"`
 }
 
-type AssetsPlatformsResponse interface{}
+// AssetPlatform contains the fields of an asset platform used for lookups,
+// items of the list are served as received from upstream
+type AssetPlatform struct {
+	ID              string `json:"id"`
+	ChainIdentifier *int64 `json:"chain_identifier"` // nil for non-EVM platforms
+	Name            string `json:"name"`
+}
+
+// AssetsPlatformsResponse represents CoinGecko /api/v3/asset_platforms response, items are kept raw
+type AssetsPlatformsResponse []json.RawMessage
@@ -0,0 +1,93 @@
+package coingecko_categories
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/fetcher_endpoint"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+const (
+	CATEGORIES_LIST_API_PATH = "/api/v3/coins/categories/list"
+)
+
+// Service periodically fetches the list of CoinGecko coin categories
+type Service struct {
+	*fetcher_endpoint.Fetcher
+	categories struct {
+		sync.RWMutex
+		list []Category
+		ids  map[string]bool
+	}
+}
+
+func NewService(config *config.Config) *Service {
+	service := &Service{}
+	service.Fetcher = fetcher_endpoint.NewFetcher(config, fetcher_endpoint.Options{
+		Name:           "Categories",
+		Path:           CATEGORIES_LIST_API_PATH,
+		MetricsService: metrics.ServiceCategories,
+		UpdateInterval: getUpdateInterval,
+		Validate:       validateCategories,
+		OnUpdated:      service.onCategoriesUpdated,
+	})
+	return service
+}
+
+func getUpdateInterval(cfg *config.Config) time.Duration {
+	return cfg.CoingeckoCategories.GetUpdateInterval()
+}
+
+// validateCategories rejects empty categories lists
+func validateCategories(data json.RawMessage) error {
+	var list []Category
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return fmt.Errorf("categories list response is empty")
+	}
+	return nil
+}
+
+// onCategoriesUpdated rebuilds the categories list and IDs from the validated response
+func (s *Service) onCategoriesUpdated(data json.RawMessage) {
+	var list []Category
+	if err := json.Unmarshal(data, &list); err != nil {
+		log.Printf("Failed to decode categories list: %v", err)
+		return
+	}
+
+	ids := make(map[string]bool, len(list))
+	for _, category := range list {
+		ids[category.CategoryID] = true
+	}
+
+	s.categories.Lock()
+	s.categories.list = list
+	s.categories.ids = ids
+	s.categories.Unlock()
+}
+
+// Categories returns the cached categories list
+func (s *Service) Categories() []Category {
+	s.categories.RLock()
+	defer s.categories.RUnlock()
+
+	result := make([]Category, len(s.categories.list))
+	copy(result, s.categories.list)
+	return result
+}
+
+// IsKnownCategory returns false only if the list is loaded and doesn't contain the category
+func (s *Service) IsKnownCategory(categoryID string) bool {
+	s.categories.RLock()
+	defer s.categories.RUnlock()
+
+	return len(s.categories.ids) == 0 || s.categories.ids[categoryID]
+}
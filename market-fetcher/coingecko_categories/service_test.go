@@ -0,0 +1,37 @@
+package coingecko_categories
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/config"
+)
+
+var testCategories = []Category{
+	{CategoryID: "layer-2", Name: "Layer 2 (L2)"},
+	{CategoryID: "decentralized-finance-defi", Name: "Decentralized Finance (DeFi)"},
+}
+
+func TestService_Categories(t *testing.T) {
+	service := NewService(&config.Config{APITokens: &config.APITokens{}})
+
+	assert.Empty(t, service.Categories())
+	assert.True(t, service.IsKnownCategory("anything"), "all categories are accepted before the list is loaded")
+
+	data, err := json.Marshal(testCategories)
+	require.NoError(t, err)
+	require.NoError(t, validateCategories(data))
+	service.onCategoriesUpdated(data)
+
+	assert.Equal(t, testCategories, service.Categories())
+	assert.True(t, service.IsKnownCategory("layer-2"))
+	assert.False(t, service.IsKnownCategory("unknown"))
+}
+
+func TestValidateCategories(t *testing.T) {
+	assert.Error(t, validateCategories(json.RawMessage(`[]`)))
+	assert.Error(t, validateCategories(json.RawMessage(`{"error":"rate limited"}`)))
+}
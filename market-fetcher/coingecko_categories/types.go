@@ -0,0 +1,7 @@
+package coingecko_categories
+
+// Category is a single entry of CoinGecko /api/v3/coins/categories/list response
+type Category struct {
+	CategoryID string `json:"category_id"`
+	Name       string `json:"name"`
+}
@@ -3,19 +3,23 @@ package coingecko_coins
 import (
 	"context"
 	"log"
+	"sync/atomic"
+	"time"
 
 	"github.com/status-im/market-proxy/cache"
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
 	"github.com/status-im/market-proxy/fetcher_by_id"
 	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 // Service manages coin data with caching using the generic framework
 type Service struct {
-	cfg            *config.Config
-	genericService *fetcher_by_id.Service
-	marketsService interfaces.IMarketsService
+	cfg              *config.Config
+	genericService   *fetcher_by_id.Service
+	marketsService   interfaces.IMarketsService
+	knownIdsProvider *knownIdsProvider
 }
 
 // marketsIdsProvider adapts IMarketsService to IIdsProvider
@@ -28,6 +32,69 @@ func (p *marketsIdsProvider) GetIds(limit int) ([]string, error) {
 	return p.marketsService.TopMarketIds(limit)
 }
 
+// knownIdsLimit is the number of top market IDs which are considered known coins
+const knownIdsLimit = 100000
+
+// knownIdsProvider implements IKnownIdsProvider with a set of IDs from tokens and markets services.
+// Coins list is filtered by supported platforms, so coins ranked in markets are known as well.
+// The set is rebuilt on tokens and markets updates and swapped atomically, so lookups do not copy IDs.
+type knownIdsProvider struct {
+	tokensService  interfaces.ITokensService
+	marketsService interfaces.IMarketsService
+	known          atomic.Pointer[map[string]struct{}]
+	subscriptions  []events.ISubscription
+}
+
+// Start rebuilds known IDs on every tokens and markets update until ctx is done
+func (p *knownIdsProvider) Start(ctx context.Context) {
+	p.subscriptions = append(p.subscriptions, p.tokensService.SubscribeOnTokensUpdate().Watch(ctx, p.rebuild, true))
+	if p.marketsService != nil {
+		p.subscriptions = append(p.subscriptions, p.marketsService.SubscribeTopMarketsUpdate().Watch(ctx, p.rebuild, false))
+	}
+}
+
+// Stop stops watching tokens and markets updates
+func (p *knownIdsProvider) Stop() {
+	for _, subscription := range p.subscriptions {
+		subscription.Cancel()
+	}
+	p.subscriptions = nil
+}
+
+// IsKnown implements fetcher_by_id.IKnownIdsProvider, all IDs are known until tokens or markets are loaded
+func (p *knownIdsProvider) IsKnown(id string) bool {
+	known := p.known.Load()
+	if known == nil {
+		p.rebuild()
+		known = p.known.Load()
+	}
+	if len(*known) == 0 {
+		return true
+	}
+	_, exists := (*known)[id]
+	return exists
+}
+
+// rebuild builds known IDs set from current tokens and markets data
+func (p *knownIdsProvider) rebuild() {
+	tokenIds := p.tokensService.GetTokenIds()
+	var marketIds []string
+	if p.marketsService != nil {
+		if ids, err := p.marketsService.TopMarketIds(knownIdsLimit); err == nil {
+			marketIds = ids
+		}
+	}
+
+	known := make(map[string]struct{}, len(tokenIds)+len(marketIds))
+	for _, id := range tokenIds {
+		known[id] = struct{}{}
+	}
+	for _, id := range marketIds {
+		known[id] = struct{}{}
+	}
+	p.known.Store(&known)
+}
+
 // NewService creates a new coins service using the generic framework
 func NewService(cfg *config.Config, marketsService interfaces.IMarketsService, cacheService cache.ICache) *Service {
 	genericService := fetcher_by_id.NewService(cfg, &cfg.CoingeckoCoins, cacheService)
@@ -40,32 +107,90 @@ func NewService(cfg *config.Config, marketsService interfaces.IMarketsService, c
 	}
 }
 
+// SetTokensService sets the coins list used to tell unknown coins from coins which are not cached yet
+func (s *Service) SetTokensService(tokensService interfaces.ITokensService) {
+	s.knownIdsProvider = &knownIdsProvider{
+		tokensService:  tokensService,
+		marketsService: s.marketsService,
+	}
+	s.genericService.SetKnownIdsProvider(s.knownIdsProvider)
+}
+
+// SetSnapshotStore enables warm-start snapshots of coins data
+func (s *Service) SetSnapshotStore(store *snapshot.Store) {
+	s.genericService.SetSnapshotStore(store)
+}
+
 // Start starts the service
 func (s *Service) Start(ctx context.Context) error {
 	log.Printf("Starting coins service")
+	if s.knownIdsProvider != nil {
+		s.knownIdsProvider.Start(ctx)
+	}
 	return s.genericService.Start(ctx)
 }
 
+// ApplyConfig implements core.IReloadable: tiers, their update intervals and TTL are applied live
+func (s *Service) ApplyConfig(cfg *config.Config) {
+	s.genericService.SetConfig(&cfg.CoingeckoCoins)
+}
+
 // Stop stops the service
 func (s *Service) Stop() {
+	if s.knownIdsProvider != nil {
+		s.knownIdsProvider.Stop()
+	}
 	s.genericService.Stop()
 }
 
-// GetCoin returns cached coin data for a specific coin ID
+// GetCoin returns coin data for a specific coin ID, fetching it on demand if enabled.
+// Returns fetcher_by_id.ErrUnknownID for unknown coins, fetcher_by_id.ErrNotCached
+// for coins which are not cached yet and fetcher_by_id.ErrUpstreamFetch if on-demand fetch fails.
 func (s *Service) GetCoin(coinID string) ([]byte, interfaces.CacheStatus, error) {
 	return s.genericService.GetByID(coinID)
 }
 
+// CoinUpdatedAt returns the last update time and update interval of the tier which fetched the coin,
+// zero time if the coin is not fetched by tiers
+func (s *Service) CoinUpdatedAt(coinID string) (time.Time, time.Duration) {
+	return s.genericService.UpdatedAt(coinID)
+}
+
 // GetMultipleCoins returns cached coin data for multiple coin IDs
 func (s *Service) GetMultipleCoins(coinIDs []string) (map[string][]byte, []string, interfaces.CacheStatus) {
 	return s.genericService.GetMultiple(coinIDs)
 }
 
+// UnknownCoins returns coin IDs which are not present in coins list and top markets
+func (s *Service) UnknownCoins(coinIDs []string) []string {
+	return s.genericService.UnknownIds(coinIDs)
+}
+
 // Healthy checks if service is initialized and has data
 func (s *Service) Healthy() bool {
 	return s.genericService.Healthy()
 }
 
+// TierStatuses implements interfaces.ITiersAdmin
+func (s *Service) TierStatuses() []interfaces.TierStatus {
+	return s.genericService.TierStatuses()
+}
+
+// RefreshAll implements interfaces.ITiersAdmin
+func (s *Service) RefreshAll() error {
+	return s.genericService.RefreshAll()
+}
+
+// RefreshTier implements interfaces.ITiersAdmin
+func (s *Service) RefreshTier(name string) error {
+	return s.genericService.RefreshTier(name)
+}
+
+// RefreshID implements interfaces.ITiersAdmin
+func (s *Service) RefreshID(id string) error {
+	return s.genericService.RefreshID(id)
+}
+
 // SubscribeOnCoinsUpdate subscribes to coins update notifications
 func (s *Service) SubscribeOnCoinsUpdate() events.ISubscription {
 	return s.genericService.SubscribeOnUpdate()
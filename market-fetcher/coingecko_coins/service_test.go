@@ -216,3 +216,28 @@ func TestMarketsIdsProvider_GetIds(t *testing.T) {
 	assert.Equal(t, "ethereum", ids[1])
 	assert.Equal(t, "solana", ids[2])
 }
+
+func TestKnownIdsProvider_IsKnown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenIds := []string{}
+	mockTokens := mock_interfaces.NewMockITokensService(ctrl)
+	mockTokens.EXPECT().GetTokenIds().DoAndReturn(func() []string { return tokenIds }).Times(2)
+	mockMarkets := mock_interfaces.NewMockIMarketsService(ctrl)
+	mockMarkets.EXPECT().TopMarketIds(knownIdsLimit).Return([]string{}, nil)
+	mockMarkets.EXPECT().TopMarketIds(knownIdsLimit).Return([]string{"bitcoin", "ethereum"}, nil)
+
+	provider := &knownIdsProvider{tokensService: mockTokens, marketsService: mockMarkets}
+
+	// All IDs are known until tokens or markets are loaded
+	assert.True(t, provider.IsKnown("not-a-coin"))
+
+	// Lookups use the set built on the last update
+	tokenIds = []string{"ethereum", "usd-coin"}
+	provider.rebuild()
+	assert.True(t, provider.IsKnown("usd-coin"))
+	// Coins without supported platforms are known from markets
+	assert.True(t, provider.IsKnown("bitcoin"))
+	assert.False(t, provider.IsKnown("not-a-coin"))
+}
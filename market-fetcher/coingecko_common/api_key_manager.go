@@ -21,6 +21,20 @@ const (
 	DemoKey
 )
 
+// String returns key type name used in configs and admin API
+func (t KeyType) String() string {
+	switch t {
+	case ProKey:
+		return "pro"
+	case DemoKey:
+		return "demo"
+	case NoKey:
+		return "none"
+	default:
+		return "unknown"
+	}
+}
+
 // APIKey represents an API key with its type
 type APIKey struct {
 	Key  string
@@ -49,14 +63,99 @@ type APIKeyManager struct {
 	mu          sync.RWMutex
 }
 
+// APIKeyState describes backoff state of an API key, the key itself is masked
+type APIKeyState struct {
+	Key          string     `json:"key"`
+	Type         string     `json:"type"`
+	LastFailed   *time.Time `json:"last_failed,omitempty"`
+	BackoffUntil *time.Time `json:"backoff_until,omitempty"` // set while the key is in backoff
+}
+
+// keyManagers holds all created key managers, each client keeps its own backoff state
+var keyManagers struct {
+	sync.Mutex
+	list []*APIKeyManager
+}
+
 // NewAPIKeyManager creates a new API key manager
 func NewAPIKeyManager(apiTokens *config.APITokens) *APIKeyManager {
-	return &APIKeyManager{
+	manager := &APIKeyManager{
 		apiTokens:   apiTokens,
 		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
 		lastFailed:  make(map[string]time.Time),
 		backoffTime: 5 * time.Minute,
 	}
+
+	keyManagers.Lock()
+	keyManagers.list = append(keyManagers.list, manager)
+	keyManagers.Unlock()
+
+	return manager
+}
+
+// GetAPIKeyStates returns states of configured keys, merged across all key managers:
+// the latest failure of a key in any client is reported
+func GetAPIKeyStates() []APIKeyState {
+	keyManagers.Lock()
+	managers := append([]*APIKeyManager{}, keyManagers.list...)
+	keyManagers.Unlock()
+
+	states := make([]APIKeyState, 0)
+	index := make(map[string]int)
+	for _, manager := range managers {
+		for _, state := range manager.keyStates() {
+			i, exists := index[state.Key]
+			if !exists {
+				index[state.Key] = len(states)
+				states = append(states, state)
+				continue
+			}
+			if state.LastFailed != nil && (states[i].LastFailed == nil || state.LastFailed.After(*states[i].LastFailed)) {
+				states[i] = state
+			}
+		}
+	}
+	return maskKeys(states)
+}
+
+// KeyStates returns backoff states of configured keys
+func (m *APIKeyManager) KeyStates() []APIKeyState {
+	return maskKeys(m.keyStates())
+}
+
+// keyStates returns backoff states of configured keys with unmasked keys
+func (m *APIKeyManager) keyStates() []APIKeyState {
+	states := make([]APIKeyState, 0)
+	for _, keyType := range []KeyType{ProKey, DemoKey} {
+		for _, key := range m.getKeysOfType(keyType) {
+			state := APIKeyState{Key: key, Type: keyType.String()}
+
+			m.mu.RLock()
+			lastFailed, failed := m.lastFailed[key]
+			m.mu.RUnlock()
+
+			if failed {
+				state.LastFailed = &lastFailed
+				if backoffUntil := lastFailed.Add(m.backoffTime); time.Now().Before(backoffUntil) {
+					state.BackoffUntil = &backoffUntil
+				}
+			}
+			states = append(states, state)
+		}
+	}
+	return states
+}
+
+// maskKeys hides all but the last 4 characters of keys
+func maskKeys(states []APIKeyState) []APIKeyState {
+	for i := range states {
+		if key := states[i].Key; len(key) > 8 {
+			states[i].Key = "****" + key[len(key)-4:]
+		} else {
+			states[i].Key = "****"
+		}
+	}
+	return states
 }
 
 // isKeyInBackoff checks if a key is currently in backoff period (private implementation)
@@ -84,11 +183,12 @@ func (m *APIKeyManager) getKeysOfType(keyType KeyType) []string {
 		return []string{}
 	}
 
+	tokens, demoTokens := m.apiTokens.Keys()
 	switch keyType {
 	case ProKey:
-		return append([]string{}, m.apiTokens.Tokens...)
+		return tokens
 	case DemoKey:
-		return append([]string{}, m.apiTokens.DemoTokens...)
+		return demoTokens
 	}
 
 	return []string{}
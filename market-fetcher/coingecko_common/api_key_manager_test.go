@@ -137,3 +137,59 @@ func TestAPIKeyManager_MarkKeyAsFailed(t *testing.T) {
 		t.Errorf("Expected %d Pro keys after backoff expired, got %d", initialProCount, afterBackoffProCount)
 	}
 }
+
+func TestAPIKeyManager_KeyStates(t *testing.T) {
+	apiTokens := &config.APITokens{
+		Tokens:     []string{"pro-key-0001", "pro-key-0002"},
+		DemoTokens: []string{"demo-key-0003"},
+	}
+
+	manager := NewAPIKeyManager(apiTokens)
+	manager.MarkKeyAsFailed("pro-key-0002")
+
+	states := manager.KeyStates()
+	if len(states) != 3 {
+		t.Fatalf("Expected 3 key states, got %d", len(states))
+	}
+
+	if states[0].Key != "****0001" || states[0].Type != "pro" || states[0].LastFailed != nil || states[0].BackoffUntil != nil {
+		t.Errorf("Unexpected state of healthy pro key: %+v", states[0])
+	}
+	if states[1].Key != "****0002" || states[1].LastFailed == nil || states[1].BackoffUntil == nil {
+		t.Errorf("Expected failed pro key to be in backoff: %+v", states[1])
+	}
+	if states[2].Key != "****0003" || states[2].Type != "demo" {
+		t.Errorf("Unexpected state of demo key: %+v", states[2])
+	}
+}
+
+func TestGetAPIKeyStates(t *testing.T) {
+	apiTokens := &config.APITokens{
+		Tokens: []string{"merged-key-a001", "merged-key-a002"},
+	}
+
+	// Key failed in one of the clients only is reported as failed
+	NewAPIKeyManager(apiTokens)
+	failedManager := NewAPIKeyManager(apiTokens)
+	failedManager.MarkKeyAsFailed("merged-key-a002")
+
+	found := 0
+	for _, state := range GetAPIKeyStates() {
+		switch state.Key {
+		case "****a001":
+			found++
+			if state.LastFailed != nil {
+				t.Errorf("Expected key a001 to be healthy: %+v", state)
+			}
+		case "****a002":
+			found++
+			if state.BackoffUntil == nil {
+				t.Errorf("Expected key a002 to be in backoff: %+v", state)
+			}
+		}
+	}
+
+	if found != 2 {
+		t.Errorf("Expected each key to be reported once, found %d states", found)
+	}
+}
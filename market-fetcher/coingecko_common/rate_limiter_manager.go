@@ -131,16 +131,7 @@ func (m *RateLimiterManager) limiterMapKey(key string, keyType KeyType) string {
 }
 
 func (m *RateLimiterManager) keyTypeString(keyType KeyType) string {
-	switch keyType {
-	case ProKey:
-		return "pro"
-	case DemoKey:
-		return "demo"
-	case NoKey:
-		return "none"
-	default:
-		return "unknown"
-	}
+	return keyType.String()
 }
 
 func (m *RateLimiterManager) parseKeyType(mapKey string) KeyType {
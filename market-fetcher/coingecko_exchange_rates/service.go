@@ -0,0 +1,85 @@
+package coingecko_exchange_rates
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/fetcher_endpoint"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+const (
+	EXCHANGE_RATES_API_PATH = "/api/v3/exchange_rates"
+)
+
+// Service periodically fetches exchange rates and converts values between currencies
+type Service struct {
+	*fetcher_endpoint.Fetcher
+	rates struct {
+		sync.RWMutex
+		data map[string]ExchangeRate
+	}
+}
+
+func NewService(config *config.Config) *Service {
+	service := &Service{}
+	service.Fetcher = fetcher_endpoint.NewFetcher(config, fetcher_endpoint.Options{
+		Name:           "ExchangeRates",
+		Path:           EXCHANGE_RATES_API_PATH,
+		MetricsService: metrics.ServiceRates,
+		UpdateInterval: getUpdateInterval,
+		Validate:       validateRates,
+		OnUpdated:      service.onRatesUpdated,
+	})
+	return service
+}
+
+func getUpdateInterval(cfg *config.Config) time.Duration {
+	return cfg.CoingeckoRates.GetUpdateInterval()
+}
+
+// validateRates rejects responses without rates, previous rates are kept
+func validateRates(data json.RawMessage) error {
+	var response ExchangeRatesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+	if len(response.Rates) == 0 {
+		return fmt.Errorf("exchange rates response is empty")
+	}
+	return nil
+}
+
+// onRatesUpdated replaces rates with the validated response
+func (s *Service) onRatesUpdated(data json.RawMessage) {
+	var response ExchangeRatesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		log.Printf("Failed to decode exchange rates: %v", err)
+		return
+	}
+
+	s.rates.Lock()
+	s.rates.data = response.Rates
+	s.rates.Unlock()
+}
+
+// ConversionRate returns the multiplier converting values in `from` currency to fiat `to` currency
+func (s *Service) ConversionRate(from, to string) (float64, bool) {
+	s.rates.RLock()
+	defer s.rates.RUnlock()
+
+	fromRate, ok := s.rates.data[from]
+	if !ok || fromRate.Value <= 0 {
+		return 0, false
+	}
+	toRate, ok := s.rates.data[to]
+	if !ok || toRate.Type != RateTypeFiat || toRate.Value <= 0 {
+		return 0, false
+	}
+
+	return toRate.Value / fromRate.Value, true
+}
@@ -0,0 +1,47 @@
+package coingecko_exchange_rates
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/config"
+)
+
+var testRates = ExchangeRatesResponse{
+	Rates: map[string]ExchangeRate{
+		"btc": {Name: "Bitcoin", Unit: "BTC", Value: 1, Type: "crypto"},
+		"usd": {Name: "US Dollar", Unit: "$", Value: 100000, Type: "fiat"},
+		"chf": {Name: "Swiss Franc", Unit: "Fr.", Value: 80000, Type: "fiat"},
+		"xau": {Name: "Gold - Troy Ounce", Unit: "XAU", Value: 40, Type: "commodity"},
+	},
+}
+
+func TestService_ConversionRate(t *testing.T) {
+	service := NewService(&config.Config{APITokens: &config.APITokens{}})
+
+	_, ok := service.ConversionRate("usd", "chf")
+	assert.False(t, ok, "no rates before first update")
+
+	data, err := json.Marshal(testRates)
+	require.NoError(t, err)
+	require.NoError(t, validateRates(data))
+	service.onRatesUpdated(data)
+
+	rate, ok := service.ConversionRate("usd", "chf")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.8, rate, 1e-9)
+
+	// Only fiat currencies can be derived
+	_, ok = service.ConversionRate("usd", "xau")
+	assert.False(t, ok)
+	_, ok = service.ConversionRate("usd", "unknown")
+	assert.False(t, ok)
+}
+
+func TestValidateRates(t *testing.T) {
+	assert.Error(t, validateRates(json.RawMessage(`{"rates":{}}`)))
+	assert.Error(t, validateRates(json.RawMessage(`[]`)))
+}
@@ -0,0 +1,19 @@
+package coingecko_exchange_rates
+
+// ExchangeRate is a single rate of /api/v3/exchange_rates response, value is relative to BTC
+type ExchangeRate struct {
+	Name  string  `json:"name"`
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+	Type  string  `json:"type"` // "crypto", "fiat" or "commodity"
+}
+
+// ExchangeRatesResponse represents CoinGecko /api/v3/exchange_rates response
+type ExchangeRatesResponse struct {
+	Rates map[string]ExchangeRate `json:"rates"`
+}
+
+const (
+	// RateTypeFiat is a type of fiat currencies rates
+	RateTypeFiat = "fiat"
+)
@@ -0,0 +1,53 @@
+package coingecko_global
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/fetcher_endpoint"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+const (
+	GLOBAL_API_PATH = "/api/v3/global"
+)
+
+// Service periodically fetches global crypto market data: total market cap, volume and dominance
+type Service struct {
+	*fetcher_endpoint.Fetcher
+}
+
+func NewService(config *config.Config) *Service {
+	return &Service{
+		Fetcher: fetcher_endpoint.NewFetcher(config, fetcher_endpoint.Options{
+			Name:           "Global",
+			Path:           GLOBAL_API_PATH,
+			MetricsService: metrics.ServiceGlobal,
+			UpdateInterval: getUpdateInterval,
+			Validate:       validateGlobal,
+		}),
+	}
+}
+
+// Global returns the cached /global response and the time it was fetched, nil if not fetched yet
+func (s *Service) Global() (json.RawMessage, time.Time) {
+	return s.Data()
+}
+
+func getUpdateInterval(cfg *config.Config) time.Duration {
+	return cfg.CoingeckoGlobal.GetUpdateInterval()
+}
+
+// validateGlobal rejects responses without market cap data
+func validateGlobal(data json.RawMessage) error {
+	var response GlobalResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+	if len(response.Data.TotalMarketCap) == 0 {
+		return fmt.Errorf("global market data response is empty")
+	}
+	return nil
+}
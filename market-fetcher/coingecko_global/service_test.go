@@ -0,0 +1,14 @@
+package coingecko_global
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGlobal(t *testing.T) {
+	assert.NoError(t, validateGlobal(json.RawMessage(`{"data":{"active_cryptocurrencies":15000,"total_market_cap":{"usd":2500000000000}}}`)))
+	assert.Error(t, validateGlobal(json.RawMessage(`{"data":{}}`)))
+	assert.Error(t, validateGlobal(json.RawMessage(`[]`)))
+}
@@ -0,0 +1,16 @@
+package coingecko_global
+
+// GlobalData contains the fields of CoinGecko /api/v3/global response used to validate it,
+// the response is served as is
+type GlobalData struct {
+	ActiveCryptocurrencies int                `json:"active_cryptocurrencies"`
+	TotalMarketCap         map[string]float64 `json:"total_market_cap"`
+	TotalVolume            map[string]float64 `json:"total_volume"`
+	MarketCapPercentage    map[string]float64 `json:"market_cap_percentage"` // Dominance by coin symbol
+	UpdatedAt              int64              `json:"updated_at"`
+}
+
+// GlobalResponse represents CoinGecko /api/v3/global response
+type GlobalResponse struct {
+	Data GlobalData `json:"data"`
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/response"
 )
 
 // Service keeps data for size-optimized list of tokens and prices:
@@ -67,6 +68,12 @@ func (s *Service) Start(ctx context.Context) error {
 	return nil
 }
 
+// ApplyConfig implements core.IReloadable: limits and currency are applied by the next update
+func (s *Service) ApplyConfig(cfg *config.Config) {
+	s.topMarketsUpdater.SetConfig(&cfg.CoingeckoLeaderboard)
+	s.topPricesUpdater.SetConfig(&cfg.CoingeckoLeaderboard)
+}
+
 func (s *Service) Stop() {
 	if s.topMarketsUpdater != nil {
 		s.topMarketsUpdater.Stop()
@@ -80,6 +87,11 @@ func (s *Service) GetCacheData() *APIResponse {
 	return s.topMarketsUpdater.GetCacheData()
 }
 
+// GetCacheSnapshot returns serialized markets data, nil if there is no data yet
+func (s *Service) GetCacheSnapshot() *response.Snapshot {
+	return s.topMarketsUpdater.GetCacheSnapshot()
+}
+
 // Healthy checks if the service can fetch at least one page of data
 func (s *Service) Healthy() bool {
 	return s.topMarketsUpdater.Healthy()
@@ -10,11 +10,13 @@ import (
 	"github.com/status-im/market-proxy/events"
 	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/response"
 )
 
 // TopMarketsUpdater handles subscription-based updates of markets leaderboard data
 type TopMarketsUpdater struct {
 	config             *config.LeaderboardFetcherConfig
+	configMu           sync.RWMutex
 	marketsFetcher     interfaces.IMarketsService
 	metricsWriter      *metrics.MetricsWriter
 	onUpdate           func()
@@ -22,7 +24,8 @@ type TopMarketsUpdater struct {
 
 	cache struct {
 		sync.RWMutex
-		data *APIResponse
+		data     *APIResponse
+		response *response.Snapshot // serialized data served by /leaderboard/markets
 	}
 }
 
@@ -36,6 +39,20 @@ func NewTopMarketsUpdater(cfg *config.LeaderboardFetcherConfig, marketsFetcher i
 	return updater
 }
 
+// getConfig returns the current leaderboard config
+func (u *TopMarketsUpdater) getConfig() *config.LeaderboardFetcherConfig {
+	u.configMu.RLock()
+	defer u.configMu.RUnlock()
+	return u.config
+}
+
+// SetConfig replaces the leaderboard config, limit and currency are applied by the next update
+func (u *TopMarketsUpdater) SetConfig(cfg *config.LeaderboardFetcherConfig) {
+	u.configMu.Lock()
+	defer u.configMu.Unlock()
+	u.config = cfg
+}
+
 // SetOnUpdateCallback sets a callback function that will be called when data is updated
 func (u *TopMarketsUpdater) SetOnUpdateCallback(onUpdate func()) {
 	u.onUpdate = onUpdate
@@ -48,6 +65,13 @@ func (u *TopMarketsUpdater) GetCacheData() *APIResponse {
 	return u.cache.data
 }
 
+// GetCacheSnapshot returns the current cached markets data serialized, nil if there is no data yet
+func (u *TopMarketsUpdater) GetCacheSnapshot() *response.Snapshot {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+	return u.cache.response
+}
+
 // Start starts the top markets updater by subscribing to market updates
 func (u *TopMarketsUpdater) Start(ctx context.Context) error {
 	u.updateSubscription = u.marketsFetcher.SubscribeTopMarketsUpdate().
@@ -74,13 +98,14 @@ func (u *TopMarketsUpdater) Stop() {
 func (u *TopMarketsUpdater) fetchAndUpdate(_ context.Context) error {
 	defer u.metricsWriter.TrackDataFetchCycle()()
 
-	limit := u.config.TopMarketsLimit
+	cfg := u.getConfig()
+	limit := cfg.TopMarketsLimit
 	if limit <= 0 {
 		limit = 500 // Default limit
 	}
 
 	startTime := time.Now()
-	data, err := u.marketsFetcher.TopMarkets(limit, u.config.Currency)
+	data, err := u.marketsFetcher.TopMarkets(limit, cfg.Currency)
 	fetchDuration := time.Since(startTime)
 
 	if err != nil {
@@ -95,8 +120,14 @@ func (u *TopMarketsUpdater) fetchAndUpdate(_ context.Context) error {
 		Data: convertedData,
 	}
 
+	marketsResponse, err := response.NewSnapshot(localData, time.Now())
+	if err != nil {
+		log.Printf("Failed to build leaderboard markets response snapshot: %v", err)
+	}
+
 	u.cache.Lock()
 	u.cache.data = localData
+	u.cache.response = marketsResponse
 	cacheSize := len(localData.Data)
 	u.cache.Unlock()
 
@@ -2,6 +2,7 @@ package coingecko_leaderboard
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"sync"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/status-im/market-proxy/interfaces"
 	mock_interfaces "github.com/status-im/market-proxy/interfaces/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -170,6 +172,13 @@ func TestTopMarketsUpdater_fetchAndUpdate(t *testing.T) {
 		assert.Len(t, cacheData.Data, 2)
 		assert.Equal(t, "bitcoin", cacheData.Data[0].ID)
 		assert.Equal(t, "ethereum", cacheData.Data[1].ID)
+
+		// Verify serialized response matches cached data
+		cacheSnapshot := updater.GetCacheSnapshot()
+		require.NotNil(t, cacheSnapshot)
+		expected, err := json.Marshal(cacheData)
+		require.NoError(t, err)
+		assert.Equal(t, expected, cacheSnapshot.Body())
 	})
 
 	t.Run("Uses default limit when config limit is 0", func(t *testing.T) {
@@ -17,6 +17,7 @@ import (
 // TopPricesUpdater handles subscription-based price updates for top tokens
 type TopPricesUpdater struct {
 	config             *config.LeaderboardFetcherConfig
+	configMu           sync.RWMutex
 	priceFetcher       cg.IPricesService
 	metricsWriter      *metrics.MetricsWriter
 	updateSubscription events.ISubscription
@@ -39,6 +40,20 @@ func NewTopPricesUpdater(cfg *config.LeaderboardFetcherConfig, priceFetcher cg.I
 	return updater
 }
 
+// getConfig returns the current leaderboard config
+func (u *TopPricesUpdater) getConfig() *config.LeaderboardFetcherConfig {
+	u.configMu.RLock()
+	defer u.configMu.RUnlock()
+	return u.config
+}
+
+// SetConfig replaces the leaderboard config, limit and currency are applied by the next update
+func (u *TopPricesUpdater) SetConfig(cfg *config.LeaderboardFetcherConfig) {
+	u.configMu.Lock()
+	defer u.configMu.Unlock()
+	u.config = cfg
+}
+
 // GetTopPricesQuotes returns cached prices quotes for top tokens in specified currency
 func (u *TopPricesUpdater) GetTopPricesQuotes(currency string) map[string]Quote {
 	u.topPricesCache.RLock()
@@ -85,12 +100,13 @@ func (u *TopPricesUpdater) Stop() {
 func (u *TopPricesUpdater) fetchAndUpdateTopPrices(ctx context.Context) error {
 	defer u.metricsWriter.TrackDataFetchCycle()()
 
-	currency := u.config.Currency
+	cfg := u.getConfig()
+	currency := cfg.Currency
 	if currency == "" {
 		currency = "usd"
 	}
 	currencies := []string{currency}
-	limit := u.config.TopPricesLimit
+	limit := cfg.TopPricesLimit
 
 	var newPricesData map[string]PriceQuotes
 	var fetchStatus string
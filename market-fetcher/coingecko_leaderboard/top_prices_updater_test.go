@@ -218,6 +218,21 @@ func TestTopPricesUpdater_fetchAndUpdateTopPrices(t *testing.T) {
 		assert.Equal(t, 3000.0, result["ethereum"].Price)
 	})
 
+	t.Run("Uses limit and currency of reloaded config", func(t *testing.T) {
+		mockFetcher := mock_interfaces.NewMockIPricesService(gomock.NewController(t))
+		updater := NewTopPricesUpdater(createTestPricesConfig(), mockFetcher)
+
+		updater.SetConfig(&config.LeaderboardFetcherConfig{
+			TopPricesLimit: 20,
+			Currency:       "eur",
+		})
+
+		mockFetcher.EXPECT().TopPrices(gomock.Any(), 20, []string{"eur"}).Return(interfaces.SimplePriceResponse{}, interfaces.CacheStatusMiss, nil)
+
+		err := updater.fetchAndUpdateTopPrices(context.Background())
+		assert.NoError(t, err)
+	})
+
 	t.Run("Handles empty price response", func(t *testing.T) {
 		cfg := createTestPricesConfig()
 		mockFetcher := mock_interfaces.NewMockIPricesService(gomock.NewController(t))
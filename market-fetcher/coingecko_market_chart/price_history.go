@@ -0,0 +1,73 @@
+package coingecko_market_chart
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+// SetPriceHistory enables serving short-range charts from local price history
+func (s *Service) SetPriceHistory(history interfaces.IPriceHistory) {
+	s.priceHistory = history
+}
+
+// marketChartFromHistory returns chart data from local price history if it covers the requested range
+func (s *Service) marketChartFromHistory(params MarketChartParams) (MarketChartResponseData, bool) {
+	if s.priceHistory == nil {
+		return nil, false
+	}
+
+	duration, step, ok := historyRange(params, s.config.CoingeckoMarketChart.DailyDataThreshold)
+	if !ok {
+		return nil, false
+	}
+
+	points, ok := s.priceHistory.MarketChart(params.ID, params.Currency, duration, step)
+	if !ok {
+		return nil, false
+	}
+
+	chartData := make(map[string]interface{}, len(points))
+	for key, values := range points {
+		chartData[key] = values
+	}
+
+	if params.DataFilter != "" {
+		filtered, err := filterByDataKeys(chartData, params.DataFilter)
+		if err != nil {
+			return nil, false
+		}
+		chartData = filtered
+	}
+
+	log.Printf("Serving market chart for coin %s from price history (%s buckets)", params.ID, step)
+	metrics.RecordMarketChartHistoryServed()
+	return MarketChartResponseData(chartData), true
+}
+
+// historyRange returns the range and bucket size of a chart which may be served from price history.
+// Without interval charts up to dailyDataThreshold days are hourly, the same as upstream data
+// fetched with rounded up days.
+func historyRange(params MarketChartParams, dailyDataThreshold int) (duration, step time.Duration, ok bool) {
+	days, err := strconv.Atoi(params.Days)
+	if err != nil {
+		return 0, 0, false
+	}
+	duration = time.Duration(days) * 24 * time.Hour
+
+	switch params.Interval {
+	case "":
+		if days <= dailyDataThreshold {
+			return duration, time.Hour, true
+		}
+	case "5m":
+		return duration, 5 * time.Minute, true
+	case "hourly":
+		return duration, time.Hour, true
+	}
+
+	return 0, 0, false
+}
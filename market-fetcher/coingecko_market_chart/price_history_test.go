@@ -0,0 +1,88 @@
+package coingecko_market_chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/cache"
+)
+
+// MockPriceHistory implements interfaces.IPriceHistory for testing
+type MockPriceHistory struct {
+	mock.Mock
+}
+
+func (m *MockPriceHistory) MarketChart(tokenID, currency string, duration, step time.Duration) (map[string][][2]float64, bool) {
+	args := m.Called(tokenID, currency, duration, step)
+	data, _ := args.Get(0).(map[string][][2]float64)
+	return data, args.Bool(1)
+}
+
+func TestService_MarketChart_ServedFromPriceHistory(t *testing.T) {
+	service := NewService(cache.NewService(cache.DefaultCacheConfig()), createTestConfig())
+	mockClient := new(MockIAPIClient)
+	service.apiClient = mockClient
+
+	history := new(MockPriceHistory)
+	history.On("MarketChart", "bitcoin", "usd", 24*time.Hour, time.Hour).Return(map[string][][2]float64{
+		"prices":        {{1000, 1}},
+		"market_caps":   {{1000, 2}},
+		"total_volumes": {{1000, 3}},
+	}, true)
+	service.SetPriceHistory(history)
+
+	result, err := service.MarketChart(MarketChartParams{ID: "bitcoin", Days: "1", DataFilter: "prices"})
+	require.NoError(t, err)
+	assert.Equal(t, MarketChartResponseData{"prices": [][2]float64{{1000, 1}}}, result)
+
+	history.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "FetchMarketChart", mock.Anything)
+}
+
+func TestService_MarketChart_FallsBackWhenHistoryMissing(t *testing.T) {
+	service := NewService(cache.NewService(cache.DefaultCacheConfig()), createTestConfig())
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchMarketChart", MarketChartParams{ID: "bitcoin", Currency: "usd", Days: "90"}).
+		Return(createTestResponseMapForService(90), nil)
+	service.apiClient = mockClient
+
+	history := new(MockPriceHistory)
+	history.On("MarketChart", "bitcoin", "usd", 24*time.Hour, time.Hour).Return(nil, false)
+	service.SetPriceHistory(history)
+
+	result, err := service.MarketChart(MarketChartParams{ID: "bitcoin", Currency: "usd", Days: "1"})
+	require.NoError(t, err)
+	assert.Contains(t, result, "prices")
+	mockClient.AssertExpectations(t)
+}
+
+func TestHistoryRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   MarketChartParams
+		duration time.Duration
+		step     time.Duration
+		ok       bool
+	}{
+		{"1 day is hourly", MarketChartParams{Days: "1"}, 24 * time.Hour, time.Hour, true},
+		{"7 days are hourly", MarketChartParams{Days: "7"}, 7 * 24 * time.Hour, time.Hour, true},
+		{"explicit hourly interval", MarketChartParams{Days: "1", Interval: "hourly"}, 24 * time.Hour, time.Hour, true},
+		{"explicit 5m interval", MarketChartParams{Days: "2", Interval: "5m"}, 48 * time.Hour, 5 * time.Minute, true},
+		{"daily data", MarketChartParams{Days: "180"}, 0, 0, false},
+		{"daily interval", MarketChartParams{Days: "7", Interval: "daily"}, 0, 0, false},
+		{"max days", MarketChartParams{Days: "max"}, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duration, step, ok := historyRange(tt.params, 90)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.duration, duration)
+			assert.Equal(t, tt.step, step)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/status-im/market-proxy/cache"
 	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
 )
 
@@ -22,6 +23,7 @@ type Service struct {
 	config        *config.Config
 	metricsWriter *metrics.MetricsWriter
 	apiClient     IAPIClient
+	priceHistory  interfaces.IPriceHistory // nil if price history is disabled
 }
 
 func NewService(cache cache.ICache, config *config.Config) *Service {
@@ -63,30 +65,40 @@ func (s *Service) MarketChart(params MarketChartParams) (MarketChartResponseData
 		params.Days = "30"
 	}
 
+	// Short-range charts are served locally when recorded price history covers them
+	if historyData, ok := s.marketChartFromHistory(params); ok {
+		return historyData, nil
+	}
+
 	// Round up parameters to maximize cache utilization
 	roundedParams := RoundUpMarketChartParams(params, s.config.CoingeckoMarketChart.DailyDataThreshold)
 
 	cacheKey := s.createCacheKey(roundedParams)
 
-	var chartData map[string]interface{}
-
-	cachedData, err := s.getCachedData(cacheKey)
-	if err == nil && cachedData != nil {
-		log.Printf("Returning cached market chart data for coin %s", params.ID)
-		chartData = cachedData
-	} else {
+	// Concurrent misses for the same chart share a single upstream fetch
+	loader := func(missingKeys []string) (map[string][]byte, error) {
 		log.Printf("ICache miss for market chart %s, fetching from API with rounded params", params.ID)
 		fetchedData, err := s.apiClient.FetchMarketChart(roundedParams)
 		if err != nil {
 			log.Printf("apiClient.FetchMarketChart failed: %v", err)
-			return nil, fmt.Errorf("failed to fetch market chart data: %w", err)
+			return nil, err
 		}
 
-		if err := s.cacheData(cacheKey, fetchedData, roundedParams); err != nil {
-			log.Printf("Failed to cache market chart data: %v", err)
+		dataBytes, err := encodeChartData(fetchedData)
+		if err != nil {
+			return nil, err
 		}
+		return map[string][]byte{cacheKey: dataBytes}, nil
+	}
 
-		chartData = s.convertBytesToInterface(fetchedData)
+	loadedData, err := s.cache.GetOrLoad([]string{cacheKey}, loader, true, s.selectTTL(roundedParams))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch market chart data: %w", err)
+	}
+
+	chartData, err := s.decodeChartData(loadedData[cacheKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode market chart data: %w", err)
 	}
 
 	// Strip the data to match original request
@@ -118,48 +130,33 @@ func (s *Service) createCacheKey(params MarketChartParams) string {
 	return baseKey
 }
 
-func (s *Service) getCachedData(cacheKey string) (map[string]interface{}, error) {
-	cacheKeys := []string{cacheKey}
-	cachedData, _, err := s.cache.Get(cacheKeys)
-	if err != nil {
-		return nil, err
-	}
-
-	if data, exists := cachedData[cacheKey]; exists {
-		var rawData map[string]json.RawMessage
-		if err := json.Unmarshal(data, &rawData); err != nil {
-			return nil, err
-		}
-
-		byteData := make(map[string][]byte)
-		for key, value := range rawData {
-			byteData[key] = []byte(value)
-		}
-
-		return s.convertBytesToInterface(byteData), nil
-	}
-
-	return nil, fmt.Errorf("data not found in cache")
-}
-
-func (s *Service) cacheData(cacheKey string, chartData map[string][]byte, params MarketChartParams) error {
+// encodeChartData serializes chart fields into a single cache value
+func encodeChartData(chartData map[string][]byte) ([]byte, error) {
 	rawData := make(map[string]json.RawMessage)
 	for key, value := range chartData {
 		rawData[key] = json.RawMessage(value)
 	}
 
-	dataBytes, err := json.Marshal(rawData)
-	if err != nil {
-		return err
+	return json.Marshal(rawData)
+}
+
+// decodeChartData deserializes cache value created by encodeChartData
+func (s *Service) decodeChartData(data []byte) (map[string]interface{}, error) {
+	if data == nil {
+		return nil, fmt.Errorf("data not found in cache")
 	}
 
-	cacheDataMap := map[string][]byte{
-		cacheKey: dataBytes,
+	var rawData map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawData); err != nil {
+		return nil, err
 	}
 
-	ttl := s.selectTTL(params)
+	byteData := make(map[string][]byte)
+	for key, value := range rawData {
+		byteData[key] = []byte(value)
+	}
 
-	return s.cache.Set(cacheDataMap, ttl)
+	return s.convertBytesToInterface(byteData), nil
 }
 
 func (s *Service) selectTTL(params MarketChartParams) time.Duration {
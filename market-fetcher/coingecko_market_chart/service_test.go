@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -572,54 +573,83 @@ func TestService_MarketChart_DataFilter(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
-func TestService_GetCachedData_NotFound(t *testing.T) {
-	// Create cache service
-	cacheConfig := cache.DefaultCacheConfig()
-	cacheService := cache.NewService(cacheConfig)
-
-	// Create test config
-	cfg := createTestConfig()
+func TestService_MarketChart_NotCachedFetchFails(t *testing.T) {
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	service := NewService(cacheService, createTestConfig())
 
-	// Create service
-	service := NewService(cacheService, cfg)
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchMarketChart", mock.Anything).Return(map[string][]byte(nil), fmt.Errorf("upstream error")).Once()
+	service.apiClient = mockClient
 
-	// Try to get non-existent data from cache
-	result, err := service.getCachedData("non-existent-key")
+	result, err := service.MarketChart(MarketChartParams{ID: "bitcoin", Currency: "usd", Days: "30"})
 
-	// Verify
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "data not found in cache")
+	mockClient.AssertExpectations(t)
 }
 
-func TestService_CacheData_Success(t *testing.T) {
-	// Create cache service
-	cacheConfig := cache.DefaultCacheConfig()
-	cacheService := cache.NewService(cacheConfig)
+func TestService_MarketChart_CachesFetchedData(t *testing.T) {
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	service := NewService(cacheService, createTestConfig())
 
-	// Create test config
-	cfg := createTestConfig()
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchMarketChart", mock.Anything).Return(sampleMarketChartData, nil).Once()
+	service.apiClient = mockClient
 
-	// Create service
-	service := NewService(cacheService, cfg)
+	params := MarketChartParams{ID: "bitcoin", Currency: "usd", Days: "30"}
+	for i := 0; i < 2; i++ {
+		result, err := service.MarketChart(params)
+		assert.NoError(t, err)
+		assert.Contains(t, result, "prices")
+	}
+
+	// Second request is served from cache
+	mockClient.AssertNumberOfCalls(t, "FetchMarketChart", 1)
+}
+
+func TestService_MarketChart_ConcurrentMissesShareFetch(t *testing.T) {
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	service := NewService(cacheService, createTestConfig())
+
+	release := make(chan struct{})
+	mockClient := new(MockIAPIClient)
+	roundedParams := MarketChartParams{
+		ID:       "bitcoin",
+		Currency: "usd",
+		Days:     "90",
+	}
+	fetchStarted := make(chan struct{})
+	mockClient.On("FetchMarketChart", roundedParams).Run(func(mock.Arguments) {
+		close(fetchStarted)
+		<-release
+	}).Return(sampleMarketChartData, nil).Once()
+	service.apiClient = mockClient
 
-	// Test caching
-	cacheKey := "test-key"
 	params := MarketChartParams{
 		ID:       "bitcoin",
 		Currency: "usd",
 		Days:     "30",
 	}
 
-	// Use sampleMarketChartData directly (it's already map[string][]byte)
-	err := service.cacheData(cacheKey, sampleMarketChartData, params)
+	var wg, started sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			result, err := service.MarketChart(params)
+			assert.NoError(t, err)
+			assert.Contains(t, result, "prices")
+		}()
+	}
 
-	// Verify
-	assert.NoError(t, err)
+	// Upstream responds once all callers are running and the fetch is in flight,
+	// callers which reach the cache later are served from it
+	started.Wait()
+	<-fetchStarted
+	close(release)
+	wg.Wait()
 
-	// Verify data was cached
-	cachedData, err := service.getCachedData(cacheKey)
-	assert.NoError(t, err)
-	assert.NotNil(t, cachedData)
-	assert.Contains(t, cachedData, "prices")
+	mockClient.AssertNumberOfCalls(t, "FetchMarketChart", 1)
 }
@@ -33,13 +33,13 @@ func parseTokensData(tokensData [][]byte) ([]interface{}, map[string][]byte, err
 	return marketData, cacheData, nil
 }
 
-// parsePagesData parses pages data and extracts page mapping with cache keys
-func parsePagesData(pagesData []PageData) (map[int]interface{}, map[string][]byte, error) {
+// parsePagesData parses pages data of the category and extracts page mapping with cache keys
+func parsePagesData(category string, pagesData []PageData) (map[int]interface{}, map[string][]byte, error) {
 	pageMapping := make(map[int]interface{})
 	cacheData := make(map[string][]byte)
 
 	for _, pageData := range pagesData {
-		cacheKey := createPageCacheKey(pageData.Page)
+		cacheKey := createPageCacheKey(category, pageData.Page)
 		pageBytes, err := json.Marshal(pageData.Data)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to marshal page data for page %d: %w", pageData.Page, err)
@@ -51,7 +51,7 @@ func parsePagesData(pagesData []PageData) (map[int]interface{}, map[string][]byt
 		// Extract token IDs from page data and create page IDs cache entry
 		tokenIDs := extractTokenIDsFromPageData(pageData.Data)
 		if len(tokenIDs) > 0 {
-			pageIdsCacheKey := createPageIdsCacheKey(pageData.Page)
+			pageIdsCacheKey := createPageIdsCacheKey(category, pageData.Page)
 			tokenIDsBytes, err := json.Marshal(tokenIDs)
 			if err == nil {
 				cacheData[pageIdsCacheKey] = tokenIDsBytes
@@ -257,7 +257,7 @@ func TestParsePagesData_PageMapping(t *testing.T) {
 	}
 
 	// Test parsePagesData
-	pageMapping, cacheData, err := parsePagesData(pagesData)
+	pageMapping, cacheData, err := parsePagesData("", pagesData)
 
 	// Assertions
 	require.NoError(t, err)
@@ -275,9 +275,9 @@ func TestParsePagesData_PageMapping(t *testing.T) {
 	assert.Equal(t, page3Data, pageMapping[3])
 
 	// Check cache data keys
-	expectedKey1 := createPageCacheKey(1)
-	expectedKey2 := createPageCacheKey(2)
-	expectedKey3 := createPageCacheKey(3)
+	expectedKey1 := createPageCacheKey("", 1)
+	expectedKey2 := createPageCacheKey("", 2)
+	expectedKey3 := createPageCacheKey("", 3)
 
 	assert.Contains(t, cacheData, expectedKey1)
 	assert.Contains(t, cacheData, expectedKey2)
@@ -303,7 +303,7 @@ func TestParsePagesData_PageMapping(t *testing.T) {
 func TestParsePagesData_EmptyInput(t *testing.T) {
 	pagesData := []PageData{}
 
-	pageMapping, cacheData, err := parsePagesData(pagesData)
+	pageMapping, cacheData, err := parsePagesData("", pagesData)
 
 	require.NoError(t, err)
 	assert.Empty(t, pageMapping)
@@ -319,7 +319,7 @@ func TestParsePagesData_SinglePage(t *testing.T) {
 		{Page: 5, Data: pageData},
 	}
 
-	pageMapping, cacheData, err := parsePagesData(pagesData)
+	pageMapping, cacheData, err := parsePagesData("", pagesData)
 
 	require.NoError(t, err)
 	assert.Len(t, pageMapping, 1)
@@ -330,6 +330,6 @@ func TestParsePagesData_SinglePage(t *testing.T) {
 	assert.Equal(t, pageData, pageMapping[5])
 
 	// Check cache data
-	expectedKey := createPageCacheKey(5)
+	expectedKey := createPageCacheKey("", 5)
 	assert.Contains(t, cacheData, expectedKey)
 }
@@ -0,0 +1,108 @@
+package coingecko_markets
+
+import (
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// monetaryFields are markets fields which are denominated in vs_currency
+var monetaryFields = []string{
+	"current_price",
+	"market_cap",
+	"fully_diluted_valuation",
+	"total_volume",
+	"high_24h",
+	"low_24h",
+	"price_change_24h",
+	"market_cap_change_24h",
+	"ath",
+	"atl",
+}
+
+// SetCurrencyConverter enables derivation of markets data in currencies which are not cached
+func (s *Service) SetCurrencyConverter(converter interfaces.ICurrencyConverter) {
+	s.currencyConverter = converter
+}
+
+// MarketsWithMeta fetches markets data like Markets and describes how the data was served.
+// Data requested in a currency other than the cached one is converted using exchange rates.
+func (s *Service) MarketsWithMeta(params interfaces.MarketsParams) (interfaces.MarketsResponse, interfaces.ResponseMeta, error) {
+	requestedCurrency := params.Currency
+
+	// Cached data is filtered in cached currency
+	if rate, ok := s.conversionRate(requestedCurrency); ok {
+		params.MinMarketCap /= rate
+		params.MinVolume /= rate
+	}
+
+	response, cacheStatus, err := s.Markets(params)
+	meta := interfaces.ResponseMeta{CacheStatus: cacheStatus}
+	if err != nil {
+		return response, meta, err
+	}
+
+	if s.convertResponse(response, requestedCurrency) {
+		meta.DerivedCurrencies = []string{requestedCurrency}
+	}
+
+	return response, meta, nil
+}
+
+// cachedCurrency returns the currency markets data is cached in
+func (s *Service) cachedCurrency() string {
+	params := s.getParamsOverride(interfaces.MarketsParams{})
+	if params.Currency == "" {
+		return "usd"
+	}
+	return params.Currency
+}
+
+// conversionRate returns the rate converting cached currency values to the given currency.
+// ok is false if the currency is the cached one or can't be derived.
+func (s *Service) conversionRate(currency string) (float64, bool) {
+	if s.currencyConverter == nil || currency == "" {
+		return 0, false
+	}
+
+	cachedCurrency := s.cachedCurrency()
+	if currency == cachedCurrency {
+		return 0, false
+	}
+
+	rate, ok := s.currencyConverter.ConversionRate(cachedCurrency, currency)
+	if !ok || rate <= 0 {
+		return 0, false
+	}
+	return rate, true
+}
+
+// convertResponse converts monetary fields of markets data from cached currency to the given one
+// Returns true if data was converted
+func (s *Service) convertResponse(response interfaces.MarketsResponse, currency string) bool {
+	rate, ok := s.conversionRate(currency)
+	if !ok {
+		return false
+	}
+
+	for _, tokenData := range response {
+		tokenMap, ok := tokenData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, field := range monetaryFields {
+			if value, ok := tokenMap[field].(float64); ok {
+				tokenMap[field] = value * rate
+			}
+		}
+		if sparkline, ok := tokenMap[SPARKLINE_FIELD].(map[string]interface{}); ok {
+			if prices, ok := sparkline["price"].([]interface{}); ok {
+				for i, price := range prices {
+					if value, ok := price.(float64); ok {
+						prices[i] = value * rate
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,43 @@
+package coingecko_markets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+type staticConverter map[string]float64
+
+func (c staticConverter) ConversionRate(from, to string) (float64, bool) {
+	rate, ok := c[from+":"+to]
+	return rate, ok
+}
+
+func TestService_ConvertResponse(t *testing.T) {
+	service := &Service{config: &config.Config{}}
+	service.SetCurrencyConverter(staticConverter{"usd:chf": 0.8})
+
+	response := interfaces.MarketsResponse{
+		map[string]interface{}{
+			"id":                          "bitcoin",
+			"current_price":               100.0,
+			"market_cap":                  1000.0,
+			"price_change_percentage_24h": 5.0,
+			"sparkline_in_7d":             map[string]interface{}{"price": []interface{}{100.0, 110.0}},
+		},
+	}
+
+	assert.False(t, service.convertResponse(response, "usd"))
+	assert.False(t, service.convertResponse(response, "jpy"))
+	assert.True(t, service.convertResponse(response, "chf"))
+
+	bitcoin := response[0].(map[string]interface{})
+	assert.InDelta(t, 80.0, bitcoin["current_price"], 1e-9)
+	assert.InDelta(t, 800.0, bitcoin["market_cap"], 1e-9)
+	assert.Equal(t, 5.0, bitcoin["price_change_percentage_24h"])
+	sparklinePrices := bitcoin["sparkline_in_7d"].(map[string]interface{})["price"].([]interface{})
+	assert.InDeltaSlice(t, []interface{}{80.0, 88.0}, sparklinePrices, 1e-9)
+}
@@ -0,0 +1,113 @@
+package coingecko_markets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// Supported values of the order parameter
+const (
+	ORDER_MARKET_CAP_DESC       = "market_cap_desc"
+	ORDER_MARKET_CAP_ASC        = "market_cap_asc"
+	ORDER_VOLUME_DESC           = "volume_desc"
+	ORDER_VOLUME_ASC            = "volume_asc"
+	ORDER_ID_ASC                = "id_asc"
+	ORDER_ID_DESC               = "id_desc"
+	ORDER_PRICE_CHANGE_24H_DESC = "price_change_percentage_24h_desc" // gainers
+	ORDER_PRICE_CHANGE_24H_ASC  = "price_change_percentage_24h_asc"  // losers
+)
+
+const (
+	MARKETS_DEFAULT_ORDER    = ORDER_MARKET_CAP_DESC
+	MARKETS_DEFAULT_PER_PAGE = 100 // CoinGecko's default per_page value
+)
+
+// ErrInvalidOrder is returned for order values which are not supported
+var ErrInvalidOrder = errors.New("invalid order")
+
+// marketsLess compares two coins, true if a goes before b
+type marketsLess func(a, b *CoinGeckoData) bool
+
+// orderComparators are comparators of supported order values
+var orderComparators = map[string]marketsLess{
+	ORDER_MARKET_CAP_DESC:       func(a, b *CoinGeckoData) bool { return a.MarketCap > b.MarketCap },
+	ORDER_MARKET_CAP_ASC:        func(a, b *CoinGeckoData) bool { return a.MarketCap < b.MarketCap },
+	ORDER_VOLUME_DESC:           func(a, b *CoinGeckoData) bool { return a.TotalVolume > b.TotalVolume },
+	ORDER_VOLUME_ASC:            func(a, b *CoinGeckoData) bool { return a.TotalVolume < b.TotalVolume },
+	ORDER_ID_ASC:                func(a, b *CoinGeckoData) bool { return a.ID < b.ID },
+	ORDER_ID_DESC:               func(a, b *CoinGeckoData) bool { return a.ID > b.ID },
+	ORDER_PRICE_CHANGE_24H_DESC: func(a, b *CoinGeckoData) bool { return a.PriceChangePercentage24h > b.PriceChangePercentage24h },
+	ORDER_PRICE_CHANGE_24H_ASC:  func(a, b *CoinGeckoData) bool { return a.PriceChangePercentage24h < b.PriceChangePercentage24h },
+}
+
+// isCachedPageRequest returns true if the request matches the cached pages as is:
+// default order, cached page size and no filters
+func (s *Service) isCachedPageRequest(params interfaces.MarketsParams) bool {
+	cached := s.getParamsOverride(interfaces.MarketsParams{Order: MARKETS_DEFAULT_ORDER, PerPage: MARKETS_DEFAULT_CHUNK_SIZE})
+
+	return (params.Order == "" || params.Order == cached.Order) &&
+		(params.PerPage == 0 || params.PerPage == cached.PerPage) &&
+		params.MinMarketCap == 0 && params.MinVolume == 0
+}
+
+// MarketsSorted sorts, filters and paginates markets data of all tiers of the requested category locally
+func (s *Service) MarketsSorted(params interfaces.MarketsParams) (interfaces.MarketsResponse, interfaces.CacheStatus, error) {
+	order := params.Order
+	if order == "" {
+		order = MARKETS_DEFAULT_ORDER
+	}
+	less, ok := orderComparators[order]
+	if !ok {
+		return nil, interfaces.CacheStatusMiss, fmt.Errorf("%w: %s", ErrInvalidOrder, order)
+	}
+
+	page := params.Page
+	if page <= 0 {
+		page = 1
+	}
+	perPage := params.PerPage
+	if perPage <= 0 {
+		perPage = MARKETS_DEFAULT_PER_PAGE
+	}
+	if perPage > MARKETS_DEFAULT_CHUNK_SIZE {
+		perPage = MARKETS_DEFAULT_CHUNK_SIZE
+	}
+
+	data, loadedTiers, totalTiers := s.periodicUpdater.GetCategoryData(params.Category)
+
+	filtered := data[:0]
+	for _, coinData := range data {
+		if coinData.MarketCap >= params.MinMarketCap && coinData.TotalVolume >= params.MinVolume {
+			filtered = append(filtered, coinData)
+		}
+	}
+
+	// Stable sort keeps market cap rank order for equal values
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return less(&filtered[i], &filtered[j])
+	})
+
+	response := make([]interface{}, 0, perPage)
+	for i := (page - 1) * perPage; i < len(filtered) && i < page*perPage; i++ {
+		var tokenData interface{}
+		if err := json.Unmarshal(filtered[i].Raw, &tokenData); err == nil {
+			response = append(response, tokenData)
+		}
+	}
+
+	var cacheStatus interfaces.CacheStatus
+	switch {
+	case totalTiers > 0 && loadedTiers == totalTiers:
+		cacheStatus = interfaces.CacheStatusFull
+	case loadedTiers > 0:
+		cacheStatus = interfaces.CacheStatusPartial
+	default:
+		cacheStatus = interfaces.CacheStatusMiss
+	}
+
+	return interfaces.MarketsResponse(response), s.markStaleRestoredData(cacheStatus), nil
+}
@@ -0,0 +1,112 @@
+package coingecko_markets
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	cache_mocks "github.com/status-im/market-proxy/cache/mocks"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+func createLocalMarketsTestService(t *testing.T) *Service {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	perPage := 2
+	cfg := createTestConfig()
+	cfg.CoingeckoMarkets.MarketParamsNormalize = &config.MarketParamsNormalize{PerPage: &perPage}
+	cfg.CoingeckoMarkets.Tiers = []config.MarketTier{
+		{Name: "top-2", PageFrom: 1, PageTo: 1, UpdateInterval: time.Minute},
+		{Name: "top-3-4", PageFrom: 2, PageTo: 2, UpdateInterval: time.Minute},
+		{Name: "layer-2", Category: "layer-2", PageFrom: 1, PageTo: 1, UpdateInterval: time.Minute},
+	}
+
+	service := NewService(cache_mocks.NewMockICache(ctrl), cfg, createMockTokensService(ctrl))
+	setTierData := func(name string, items ...string) {
+		tokensData := make([][]byte, len(items))
+		for i, item := range items {
+			tokensData[i] = []byte(item)
+		}
+		service.periodicUpdater.cache.tiers[name] = &TierDataWithTimestamp{
+			Data:      ConvertMarketsResponseToCoinGeckoData(tokensData),
+			Timestamp: time.Now(),
+		}
+	}
+	setTierData("top-2",
+		`{"id":"bitcoin","market_cap":1000,"total_volume":50,"price_change_percentage_24h":1.5}`,
+		`{"id":"ethereum","market_cap":500,"total_volume":80,"price_change_percentage_24h":-3}`)
+	// "ethereum" is also in the next tier after rank change, it is returned once
+	setTierData("top-3-4",
+		`{"id":"ethereum","market_cap":500,"total_volume":80,"price_change_percentage_24h":-3}`,
+		`{"id":"arbitrum","market_cap":100,"total_volume":90,"price_change_percentage_24h":7}`,
+		`{"id":"aave","market_cap":50,"total_volume":5,"price_change_percentage_24h":0}`)
+	setTierData("layer-2", `{"id":"arbitrum","market_cap":100,"total_volume":90,"price_change_percentage_24h":7}`)
+
+	return service
+}
+
+func TestService_MarketsSorted(t *testing.T) {
+	service := createLocalMarketsTestService(t)
+
+	tests := []struct {
+		name     string
+		params   interfaces.MarketsParams
+		expected []string
+	}{
+		{"volume desc", interfaces.MarketsParams{Order: ORDER_VOLUME_DESC, PerPage: 10}, []string{"arbitrum", "ethereum", "bitcoin", "aave"}},
+		{"volume asc", interfaces.MarketsParams{Order: ORDER_VOLUME_ASC, PerPage: 10}, []string{"aave", "bitcoin", "ethereum", "arbitrum"}},
+		{"id asc", interfaces.MarketsParams{Order: ORDER_ID_ASC, PerPage: 10}, []string{"aave", "arbitrum", "bitcoin", "ethereum"}},
+		{"id desc", interfaces.MarketsParams{Order: ORDER_ID_DESC, PerPage: 10}, []string{"ethereum", "bitcoin", "arbitrum", "aave"}},
+		{"market cap asc", interfaces.MarketsParams{Order: ORDER_MARKET_CAP_ASC, PerPage: 10}, []string{"aave", "arbitrum", "ethereum", "bitcoin"}},
+		{"gainers", interfaces.MarketsParams{Order: ORDER_PRICE_CHANGE_24H_DESC, PerPage: 2}, []string{"arbitrum", "bitcoin"}},
+		{"losers", interfaces.MarketsParams{Order: ORDER_PRICE_CHANGE_24H_ASC, PerPage: 2}, []string{"ethereum", "aave"}},
+		{"second page", interfaces.MarketsParams{Order: ORDER_ID_ASC, Page: 2, PerPage: 3}, []string{"ethereum"}},
+		{"page out of range", interfaces.MarketsParams{Order: ORDER_ID_ASC, Page: 3, PerPage: 3}, []string{}},
+		{"min market cap", interfaces.MarketsParams{MinMarketCap: 100, PerPage: 10}, []string{"bitcoin", "ethereum", "arbitrum"}},
+		{"min volume", interfaces.MarketsParams{Order: ORDER_ID_ASC, MinVolume: 60, PerPage: 10}, []string{"arbitrum", "ethereum"}},
+		{"category", interfaces.MarketsParams{Category: "layer-2", Order: ORDER_VOLUME_DESC}, []string{"arbitrum"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, cacheStatus, err := service.Markets(tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, interfaces.CacheStatusFull, cacheStatus)
+			assert.Equal(t, tt.expected, extractTokenIDs(response))
+		})
+	}
+}
+
+func TestService_MarketsSorted_StatusAndErrors(t *testing.T) {
+	service := createLocalMarketsTestService(t)
+
+	_, _, err := service.Markets(interfaces.MarketsParams{Order: "price_desc"})
+	assert.True(t, errors.Is(err, ErrInvalidOrder))
+
+	response, cacheStatus, err := service.Markets(interfaces.MarketsParams{Order: ORDER_VOLUME_DESC, Category: "meme-token"})
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusMiss, cacheStatus)
+	assert.Empty(t, response)
+
+	delete(service.periodicUpdater.cache.tiers, "top-3-4")
+	response, cacheStatus, err = service.Markets(interfaces.MarketsParams{Order: ORDER_VOLUME_DESC})
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusPartial, cacheStatus)
+	assert.Equal(t, []string{"ethereum", "bitcoin"}, extractTokenIDs(response))
+}
+
+func TestService_isCachedPageRequest(t *testing.T) {
+	service := createLocalMarketsTestService(t)
+
+	assert.True(t, service.isCachedPageRequest(interfaces.MarketsParams{Page: 1}))
+	assert.True(t, service.isCachedPageRequest(interfaces.MarketsParams{Page: 1, Order: ORDER_MARKET_CAP_DESC, PerPage: 2}))
+	assert.False(t, service.isCachedPageRequest(interfaces.MarketsParams{Page: 1, Order: ORDER_VOLUME_DESC}))
+	assert.False(t, service.isCachedPageRequest(interfaces.MarketsParams{Page: 1, PerPage: 100}))
+	assert.False(t, service.isCachedPageRequest(interfaces.MarketsParams{Page: 1, MinVolume: 1}))
+}
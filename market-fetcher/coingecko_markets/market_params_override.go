@@ -11,7 +11,7 @@ import (
 // This function applies parameter overrides from the configuration to ensure
 // consistent caching behavior regardless of user input parameters.
 func (s *Service) getParamsOverride(params interfaces.MarketsParams) interfaces.MarketsParams {
-	return ApplyParamsOverride(params, &s.config.CoingeckoMarkets)
+	return ApplyParamsOverride(params, &s.getConfig().CoingeckoMarkets)
 }
 
 // ApplyParamsOverride normalizes MarketParams according to MarketsFetcherConfig configuration
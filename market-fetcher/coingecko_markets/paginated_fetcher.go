@@ -10,8 +10,9 @@ import (
 
 // PageData represents data for a single page
 type PageData struct {
-	Page int
-	Data [][]byte
+	Page       int
+	Data       [][]byte
+	Sparklines map[string][]byte // Token ID -> sparkline_in_7d data split out of Data
 }
 
 const (
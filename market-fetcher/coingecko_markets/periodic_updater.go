@@ -2,8 +2,10 @@ package coingecko_markets
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,17 +13,35 @@ import (
 	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
 	"github.com/status-im/market-proxy/scheduler"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
+const snapshotName = "markets"
+
+// tierSnapshot is a persisted form of tier pages with timestamp
+type tierSnapshot struct {
+	Pages     []pageSnapshot `json:"pages"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+type pageSnapshot struct {
+	Page int               `json:"page"`
+	Data []json.RawMessage `json:"data"`
+}
+
 // PeriodicUpdater handles periodic updates of markets data
 type PeriodicUpdater struct {
 	config                  *config.MarketsFetcherConfig
+	configMu                sync.RWMutex         // protects config replaced on config reload
 	scheduler               *scheduler.Scheduler // Single scheduler for all tiers
 	apiClient               IAPIClient
 	metricsWriter           *metrics.MetricsWriter
+	snapshotStore           *snapshot.Store
+	snapshotSaver           *snapshot.Saver
 	onUpdateTierPages       func(ctx context.Context, tier config.MarketTier, pagesData []PageData)
 	onUpdateMissingExtraIds func(ctx context.Context, tokensData [][]byte)
 	onInitialLoadCompleted  func(ctx context.Context)
+	onTierRestored          func(ctx context.Context, tier config.MarketTier, pagesData []PageData, updatedAt time.Time)
 
 	// Cache for markets data per tier with timestamps
 	cache struct {
@@ -57,6 +77,35 @@ func NewPeriodicUpdater(cfg *config.MarketsFetcherConfig, apiClient IAPIClient)
 	return updater
 }
 
+// getConfig returns the current fetcher config
+func (u *PeriodicUpdater) getConfig() *config.MarketsFetcherConfig {
+	u.configMu.RLock()
+	defer u.configMu.RUnlock()
+	return u.config
+}
+
+// SetConfig replaces the fetcher config, new tiers and intervals are picked up by the next tiers check.
+// Data of tiers which are not configured anymore is dropped.
+func (u *PeriodicUpdater) SetConfig(cfg *config.MarketsFetcherConfig) {
+	u.configMu.Lock()
+	u.config = cfg
+	u.configMu.Unlock()
+
+	configured := make(map[string]bool, len(cfg.Tiers))
+	for _, tier := range cfg.Tiers {
+		configured[tier.Name] = true
+	}
+
+	u.cache.Lock()
+	defer u.cache.Unlock()
+	for name := range u.cache.tiers {
+		if !configured[name] {
+			log.Printf("Markets tier '%s' removed from config, dropping its data", name)
+			delete(u.cache.tiers, name)
+		}
+	}
+}
+
 // SetOnUpdateTierPagesCallback sets a callback function that will be called when tier data is updated
 func (u *PeriodicUpdater) SetOnUpdateTierPagesCallback(onUpdateTierPages func(ctx context.Context, tier config.MarketTier, pagesData []PageData)) {
 	u.onUpdateTierPages = onUpdateTierPages
@@ -67,11 +116,21 @@ func (u *PeriodicUpdater) SetOnUpdateMissingExtraIdsCallback(onUpdateMissingExtr
 	u.onUpdateMissingExtraIds = onUpdateMissingExtraIds
 }
 
+// SetOnTierRestoredCallback sets a callback function that will be called when tier pages are restored from snapshot
+func (u *PeriodicUpdater) SetOnTierRestoredCallback(onTierRestored func(ctx context.Context, tier config.MarketTier, pagesData []PageData, updatedAt time.Time)) {
+	u.onTierRestored = onTierRestored
+}
+
 // SetOnInitialLoadCompletedCallback sets a callback function that will be called when all tiers complete their initial load
 func (u *PeriodicUpdater) SetOnInitialLoadCompletedCallback(onInitialLoadCompleted func(ctx context.Context)) {
 	u.onInitialLoadCompleted = onInitialLoadCompleted
 }
 
+// SetSnapshotStore enables warm-start snapshots of tier data
+func (u *PeriodicUpdater) SetSnapshotStore(store *snapshot.Store) {
+	u.snapshotStore = store
+}
+
 // SetExtraIds sets the list of extra token IDs to fetch
 func (u *PeriodicUpdater) SetExtraIds(ids []string) {
 	u.extraIds.Lock()
@@ -112,14 +171,171 @@ func (u *PeriodicUpdater) GetCacheDataForTier(tierName string) *APIResponse {
 	return &APIResponse{Data: tierData.Data}
 }
 
+// GetCategoryData returns cached data of all tiers of the category (empty for top markets) in page order,
+// tokens present in several tiers are returned once. Also returns how many of the tiers have data.
+func (u *PeriodicUpdater) GetCategoryData(category string) (data []CoinGeckoData, loadedTiers int, totalTiers int) {
+	tiers := make([]config.MarketTier, 0, len(u.getConfig().Tiers))
+	for _, tier := range u.getConfig().Tiers {
+		if tier.Category == category {
+			tiers = append(tiers, tier)
+		}
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].PageFrom < tiers[j].PageFrom
+	})
+
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	seen := make(map[string]bool)
+	for _, tier := range tiers {
+		tierData := u.cache.tiers[tier.Name]
+		if tierData == nil || len(tierData.Data) == 0 {
+			continue
+		}
+		loadedTiers++
+		for _, coinData := range tierData.Data {
+			if !seen[coinData.ID] {
+				seen[coinData.ID] = true
+				data = append(data, coinData)
+			}
+		}
+	}
+
+	return data, loadedTiers, len(tiers)
+}
+
 func (u *PeriodicUpdater) Start(ctx context.Context) error {
-	if err := u.config.Validate(); err != nil {
+	if err := u.getConfig().Validate(); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	u.restoreSnapshot(ctx)
+	u.snapshotSaver = snapshot.NewSaver(u.snapshotStore, snapshotName, u.snapshot)
+	u.snapshotSaver.Start(ctx)
+
 	return u.startAllTiers(ctx)
 }
 
+// restoreSnapshot loads tier pages saved by a previous run and passes them to the callback.
+// Tiers older than the TTL are not restored.
+func (u *PeriodicUpdater) restoreSnapshot(ctx context.Context) {
+	var tiers map[string]tierSnapshot
+	found, err := u.snapshotStore.Load(snapshotName, &tiers)
+	if err != nil {
+		log.Printf("Failed to restore markets snapshot: %v", err)
+		return
+	}
+	if !found {
+		return
+	}
+
+	cfg := u.getConfig()
+	for _, tier := range cfg.Tiers {
+		saved, exists := tiers[tier.Name]
+		if !exists || len(saved.Pages) == 0 {
+			continue
+		}
+		if time.Since(saved.Timestamp) >= cfg.GetTTL() {
+			log.Printf("Skipping markets tier '%s' snapshot, it is older than TTL (updated at %v)",
+				tier.Name, saved.Timestamp.Format(time.RFC3339))
+			continue
+		}
+
+		pagesData := make([]PageData, 0, len(saved.Pages))
+		var tokensData [][]byte
+		for _, page := range saved.Pages {
+			pageData := PageData{Page: page.Page, Data: make([][]byte, 0, len(page.Data))}
+			for _, raw := range page.Data {
+				pageData.Data = append(pageData.Data, raw)
+			}
+			pagesData = append(pagesData, pageData)
+			tokensData = append(tokensData, pageData.Data...)
+		}
+
+		u.cache.Lock()
+		u.cache.tiers[tier.Name] = &TierDataWithTimestamp{
+			Data:      ConvertMarketsResponseToCoinGeckoData(tokensData),
+			Pages:     pagesData,
+			Timestamp: saved.Timestamp,
+			Restored:  true,
+		}
+		u.cache.Unlock()
+
+		log.Printf("Restored markets tier '%s' from snapshot with %d pages (updated at %v)",
+			tier.Name, len(pagesData), saved.Timestamp.Format(time.RFC3339))
+
+		if u.onTierRestored != nil {
+			u.onTierRestored(ctx, tier, pagesData, saved.Timestamp)
+		}
+	}
+}
+
+// snapshot returns tier pages to persist
+func (u *PeriodicUpdater) snapshot() (interface{}, error) {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	tiers := make(map[string]tierSnapshot, len(u.cache.tiers))
+	for name, tierData := range u.cache.tiers {
+		if tierData == nil || len(tierData.Pages) == 0 {
+			continue
+		}
+		pages := make([]pageSnapshot, 0, len(tierData.Pages))
+		for _, pageData := range tierData.Pages {
+			page := pageSnapshot{Page: pageData.Page, Data: make([]json.RawMessage, 0, len(pageData.Data))}
+			for _, raw := range pageData.Data {
+				page.Data = append(page.Data, raw)
+			}
+			pages = append(pages, page)
+		}
+		tiers[name] = tierSnapshot{Pages: pages, Timestamp: tierData.Timestamp}
+	}
+
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+	return tiers, nil
+}
+
+// TierUpdate returns the last update time and update interval of the category tier holding the page.
+// For page 0 the least recently updated tier of the category is returned.
+// ok is false if no matching tier has data.
+func (u *PeriodicUpdater) TierUpdate(category string, page int) (updatedAt time.Time, interval time.Duration, ok bool) {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	for _, tier := range u.getConfig().Tiers {
+		if tier.Category != category || (page > 0 && (page < tier.PageFrom || page > tier.PageTo)) {
+			continue
+		}
+		tierData := u.cache.tiers[tier.Name]
+		if tierData == nil || tierData.Timestamp.IsZero() {
+			continue
+		}
+		if !ok || tierData.Timestamp.Before(updatedAt) {
+			updatedAt, interval, ok = tierData.Timestamp, tier.UpdateInterval, true
+		}
+	}
+	return updatedAt, interval, ok
+}
+
+// HasStaleRestoredData returns true if data restored from snapshot is older
+// than its tier update interval and hasn't been refreshed yet
+func (u *PeriodicUpdater) HasStaleRestoredData() bool {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	now := time.Now()
+	for _, tier := range u.getConfig().Tiers {
+		tierData := u.cache.tiers[tier.Name]
+		if tierData != nil && tierData.Restored && now.Sub(tierData.Timestamp) >= tier.UpdateInterval {
+			return true
+		}
+	}
+	return false
+}
+
 // startAllTiers starts a single scheduler that manages all tiers
 func (u *PeriodicUpdater) startAllTiers(ctx context.Context) error {
 	// Create single scheduler that runs every 2 seconds
@@ -141,13 +357,94 @@ func (u *PeriodicUpdater) Stop() {
 	if u.scheduler != nil {
 		u.scheduler.Stop()
 	}
+	if u.snapshotSaver != nil {
+		u.snapshotSaver.Stop()
+	}
+}
+
+// TierStatuses returns update status of configured tiers
+func (u *PeriodicUpdater) TierStatuses() []interfaces.TierStatus {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	tiers := u.getConfig().Tiers
+	statuses := make([]interfaces.TierStatus, 0, len(tiers))
+	for _, tier := range tiers {
+		status := interfaces.TierStatus{Name: tier.Name}
+		if tierData := u.cache.tiers[tier.Name]; tierData != nil {
+			status.LastUpdate = tierData.Timestamp
+			status.ItemCount = len(tierData.Data)
+			status.UpdatingSince = tierData.UpdateStartTime
+			status.Restored = tierData.Restored
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// ForceUpdate starts updates of all tiers which are not updating
+func (u *PeriodicUpdater) ForceUpdate(ctx context.Context) {
+	tiers := u.getConfig().Tiers
+	log.Printf("Force updating all %d markets tiers", len(tiers))
+	for _, tier := range tiers {
+		if err := u.RefreshTier(ctx, tier.Name); err != nil {
+			log.Printf("Skipping force update of markets tier '%s': %v", tier.Name, err)
+		}
+	}
+}
+
+// RefreshTier starts an update of the named tier in background
+func (u *PeriodicUpdater) RefreshTier(ctx context.Context, name string) error {
+	var tier *config.MarketTier
+	for _, t := range u.getConfig().Tiers {
+		if t.Name == name {
+			tier = &t
+			break
+		}
+	}
+	if tier == nil {
+		return fmt.Errorf("%w: %s", interfaces.ErrUnknownTier, name)
+	}
+
+	u.cache.RLock()
+	tierData := u.cache.tiers[name]
+	isUpdating := tierData != nil && tierData.UpdateStartTime != nil
+	u.cache.RUnlock()
+	if isUpdating {
+		return fmt.Errorf("%w: %s", interfaces.ErrTierUpdating, name)
+	}
+
+	log.Printf("Force updating markets tier '%s'", name)
+	go func(t config.MarketTier) {
+		if err := u.fetchAndUpdateTier(ctx, t); err != nil {
+			log.Printf("Error updating markets tier '%s' data: %v", t.Name, err)
+		}
+	}(*tier)
+	return nil
+}
+
+// RefreshID fetches markets data of a single ID in background and passes it to the missing extra IDs callback
+func (u *PeriodicUpdater) RefreshID(ctx context.Context, id string) {
+	go func() {
+		params := ApplyParamsOverride(interfaces.MarketsParams{IDs: []string{id}}, u.getConfig())
+
+		tokensData, err := NewChunksFetcher(u.apiClient, 1, 0).FetchMarkets(ctx, params, nil)
+		if err != nil {
+			log.Printf("Failed to refresh markets data of '%s': %v", id, err)
+			return
+		}
+
+		if len(tokensData) > 0 && u.onUpdateMissingExtraIds != nil {
+			u.onUpdateMissingExtraIds(ctx, tokensData)
+		}
+	}()
 }
 
 // checkAndUpdateTiers checks all tiers and starts updates if needed
 func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context) {
 	now := time.Now()
 
-	for _, tier := range u.config.Tiers {
+	for _, tier := range u.getConfig().Tiers {
 		shouldUpdate := false
 		var lastUpdate time.Time
 		var isUpdating bool
@@ -217,21 +514,27 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ma
 		u.setTierUpdateStartTime(tier.Name, nil)
 	}()
 
-	requestDelay := u.config.RequestDelay
+	requestDelay := u.getConfig().RequestDelay
 	requestDelayMs := int(requestDelay.Milliseconds())
 	if requestDelayMs < 0 {
 		requestDelayMs = MARKETS_DEFAULT_REQUEST_DELAY
 	}
 
 	params := interfaces.MarketsParams{}
-	params = ApplyParamsOverride(params, u.config)
+	params = ApplyParamsOverride(params, u.getConfig())
+	if tier.Category != "" {
+		params.Category = tier.Category
+	}
+	if tier.Sparkline {
+		params.SparklineEnabled = true
+	}
 
 	fetcher := NewPaginatedFetcher(u.apiClient, tier.PageFrom, tier.PageTo, requestDelayMs, params)
 
 	// Create onPage callback to update cache with partial data (non-blocking)
 	onPageCallback := func(pageData PageData) {
 		if u.onUpdateTierPages != nil {
-			go u.onUpdateTierPages(ctx, tier, []PageData{pageData})
+			go u.onUpdateTierPages(ctx, tier, []PageData{splitSparklines(pageData)})
 		}
 	}
 
@@ -241,6 +544,11 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ma
 		return err
 	}
 
+	// Sparklines are kept out of tier data so they don't bloat every page
+	for i := range pagesData {
+		pagesData[i] = splitSparklines(pagesData[i])
+	}
+
 	// Flatten pages data for further processing
 	var tokensData [][]byte
 	for _, pageData := range pagesData {
@@ -250,6 +558,7 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ma
 	// Final cache update - replace with complete data to ensure consistency
 	localData := &TierDataWithTimestamp{
 		Data:            ConvertMarketsResponseToCoinGeckoData(tokensData),
+		Pages:           pagesData,
 		Timestamp:       time.Now(),
 		UpdateStartTime: nil, // Will be cleared by defer
 	}
@@ -307,10 +616,10 @@ func (u *PeriodicUpdater) fetchMissingExtraIds(ctx context.Context, tier config.
 	params := interfaces.MarketsParams{
 		IDs: missingIds,
 	}
-	params = ApplyParamsOverride(params, u.config)
+	params = ApplyParamsOverride(params, u.getConfig())
 
 	// Use chunks fetcher to handle large number of missing IDs
-	requestDelay := u.config.RequestDelay
+	requestDelay := u.getConfig().RequestDelay
 	requestDelayMs := int(requestDelay.Milliseconds())
 	if requestDelayMs < 0 {
 		requestDelayMs = MARKETS_DEFAULT_REQUEST_DELAY
@@ -349,7 +658,7 @@ func (u *PeriodicUpdater) findMissingOrStaleIds(extraIds []string) []string {
 	u.cache.RLock()
 	defer u.cache.RUnlock()
 
-	halfTTL := u.config.GetTTL() / 2
+	halfTTL := u.getConfig().GetTTL() / 2
 	now := time.Now()
 	missingIds := make([]string, 0)
 
@@ -399,9 +708,9 @@ func (u *PeriodicUpdater) checkAndTriggerInitialLoadCompleted(ctx context.Contex
 	}
 
 	// Check if all tiers are completed and we haven't triggered the callback yet
-	if !u.initialLoad.allCompleted && len(u.initialLoad.completedTiers) == len(u.config.Tiers) {
+	if !u.initialLoad.allCompleted && len(u.initialLoad.completedTiers) == len(u.getConfig().Tiers) {
 		allCompleted := true
-		for _, tier := range u.config.Tiers {
+		for _, tier := range u.getConfig().Tiers {
 			if !u.initialLoad.completedTiers[tier.Name] {
 				allCompleted = false
 				break
@@ -410,7 +719,7 @@ func (u *PeriodicUpdater) checkAndTriggerInitialLoadCompleted(ctx context.Contex
 
 		if allCompleted {
 			u.initialLoad.allCompleted = true
-			log.Printf("All %d tiers completed initial load", len(u.config.Tiers))
+			log.Printf("All %d tiers completed initial load", len(u.getConfig().Tiers))
 
 			if u.onInitialLoadCompleted != nil {
 				go u.onInitialLoadCompleted(ctx)
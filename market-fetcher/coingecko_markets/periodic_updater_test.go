@@ -11,6 +11,7 @@ import (
 	api_mocks "github.com/status-im/market-proxy/coingecko_markets/mocks"
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/snapshot"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -293,6 +294,26 @@ func TestPeriodicUpdater_fetchAndUpdateTier(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Category tier fetches pages of its category", func(t *testing.T) {
+		emptyCategory := ""
+		cfg := createTestPeriodicUpdaterConfig()
+		cfg.MarketParamsNormalize.Category = &emptyCategory
+		ctrl := gomock.NewController(t)
+		mockFetcher := api_mocks.NewMockIAPIClient(ctrl)
+		updater := NewPeriodicUpdater(cfg, mockFetcher)
+
+		mockFetcher.EXPECT().FetchPage(gomock.Any()).DoAndReturn(func(params interfaces.MarketsParams) ([][]byte, error) {
+			assert.Equal(t, "layer-2", params.Category)
+			return [][]byte{[]byte(`{"id":"optimism"}`)}, nil
+		})
+
+		tier := config.MarketTier{Name: "layer-2", Category: "layer-2", PageFrom: 1, PageTo: 1, UpdateInterval: time.Minute}
+		err := updater.fetchAndUpdateTier(context.Background(), tier)
+
+		assert.NoError(t, err)
+		assert.Len(t, updater.GetCacheDataForTier(tier.Name).Data, 1)
+	})
+
 	t.Run("Handles fetcher error", func(t *testing.T) {
 		cfg := createTestPeriodicUpdaterConfig()
 		mockFetcher := api_mocks.NewMockIAPIClient(gomock.NewController(t))
@@ -684,3 +705,105 @@ func TestConvertMarketsResponseToCoinGeckoData(t *testing.T) {
 		assert.Equal(t, 0, coin.MarketCapRank)
 	})
 }
+
+func TestPeriodicUpdater_Snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := snapshot.NewStore(snapshot.Config{Dir: t.TempDir()})
+	cfg := createTestPeriodicUpdaterConfig()
+
+	mockFetcher := api_mocks.NewMockIAPIClient(ctrl)
+	setupMockFetchPage(mockFetcher, createSampleMarketsData(), nil)
+	updater := NewPeriodicUpdater(cfg, mockFetcher)
+	updater.SetSnapshotStore(store)
+
+	assert.NoError(t, updater.fetchAndUpdateTier(context.Background(), cfg.Tiers[0]))
+	state, err := updater.snapshot()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Save(snapshotName, state))
+
+	// New updater restores pages from snapshot and passes them to the callback
+	restored := NewPeriodicUpdater(cfg, api_mocks.NewMockIAPIClient(ctrl))
+	restored.SetSnapshotStore(store)
+
+	var restoredPages []PageData
+	var restoredAt time.Time
+	restored.SetOnTierRestoredCallback(func(ctx context.Context, tier config.MarketTier, pagesData []PageData, updatedAt time.Time) {
+		restoredPages = pagesData
+		restoredAt = updatedAt
+	})
+	restored.restoreSnapshot(context.Background())
+
+	assert.Len(t, restoredPages, 2)
+	assert.Equal(t, updater.cache.tiers["tier1"].Timestamp.Unix(), restoredAt.Unix())
+	tierData := restored.GetCacheDataForTier("tier1")
+	assert.Len(t, tierData.Data, 4)
+	assert.Equal(t, "bitcoin", tierData.Data[0].ID)
+
+	// Fresh snapshot data is not stale, outdated one is
+	assert.False(t, restored.HasStaleRestoredData())
+	restored.cache.tiers["tier1"].Timestamp = time.Now().Add(-time.Hour)
+	assert.True(t, restored.HasStaleRestoredData())
+}
+
+func TestPeriodicUpdater_SnapshotOlderThanTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := snapshot.NewStore(snapshot.Config{Dir: t.TempDir()})
+	cfg := createTestPeriodicUpdaterConfig()
+	assert.NoError(t, store.Save(snapshotName, map[string]tierSnapshot{
+		"tier1": {
+			Pages:     []pageSnapshot{{Page: 1, Data: []json.RawMessage{json.RawMessage(`{"id":"bitcoin"}`)}}},
+			Timestamp: time.Now().Add(-cfg.GetTTL() - time.Minute),
+		},
+	}))
+
+	restored := NewPeriodicUpdater(cfg, api_mocks.NewMockIAPIClient(ctrl))
+	restored.SetSnapshotStore(store)
+	restored.SetOnTierRestoredCallback(func(ctx context.Context, tier config.MarketTier, pagesData []PageData, updatedAt time.Time) {
+		assert.Fail(t, "expired tier should not be restored")
+	})
+	restored.restoreSnapshot(context.Background())
+
+	assert.Nil(t, restored.GetCacheDataForTier("tier1"))
+}
+
+func TestPeriodicUpdater_TierUpdate(t *testing.T) {
+	cfg := &config.MarketsFetcherConfig{
+		Tiers: []config.MarketTier{
+			{Name: "top", PageFrom: 1, PageTo: 2, UpdateInterval: time.Minute},
+			{Name: "tail", PageFrom: 3, PageTo: 10, UpdateInterval: time.Hour},
+			{Name: "defi", PageFrom: 1, PageTo: 1, UpdateInterval: 10 * time.Minute, Category: "defi"},
+		},
+	}
+	updater := NewPeriodicUpdater(cfg, nil)
+
+	topUpdate := time.Now().Add(-30 * time.Second)
+	tailUpdate := time.Now().Add(-20 * time.Minute)
+	updater.cache.tiers["top"] = &TierDataWithTimestamp{Timestamp: topUpdate}
+	updater.cache.tiers["tail"] = &TierDataWithTimestamp{Timestamp: tailUpdate}
+
+	// The tier holding the page is used
+	updatedAt, interval, ok := updater.TierUpdate("", 2)
+	assert.True(t, ok)
+	assert.Equal(t, topUpdate, updatedAt)
+	assert.Equal(t, time.Minute, interval)
+
+	updatedAt, interval, ok = updater.TierUpdate("", 5)
+	assert.True(t, ok)
+	assert.Equal(t, tailUpdate, updatedAt)
+	assert.Equal(t, time.Hour, interval)
+
+	// Without a page the least recently updated tier of the category is used
+	updatedAt, _, ok = updater.TierUpdate("", 0)
+	assert.True(t, ok)
+	assert.Equal(t, tailUpdate, updatedAt)
+
+	// Tiers without data and pages out of tiers are not reported
+	_, _, ok = updater.TierUpdate("defi", 1)
+	assert.False(t, ok)
+	_, _, ok = updater.TierUpdate("", 11)
+	assert.False(t, ok)
+}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/status-im/market-proxy/cache"
 	cfg "github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
 	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 const (
@@ -23,6 +26,7 @@ const (
 type Service struct {
 	cache                          cache.ICache
 	config                         *cfg.Config
+	configMu                       sync.RWMutex // protects config replaced on config reload
 	metricsWriter                  *metrics.MetricsWriter
 	subscriptionManager            *events.SubscriptionManager
 	initializedSubscriptionManager *events.SubscriptionManager
@@ -30,6 +34,9 @@ type Service struct {
 	tokensService                  interfaces.ITokensService
 	tokenUpdateSubscription        events.ISubscription
 	topIdsManager                  *TopIdsManager
+	currencyConverter              interfaces.ICurrencyConverter
+	// ctx is the service lifetime context used for background refreshes
+	ctx context.Context
 }
 
 func NewService(cache cache.ICache, config *cfg.Config, tokensService interfaces.ITokensService) *Service {
@@ -51,42 +58,96 @@ func NewService(cache cache.ICache, config *cfg.Config, tokensService interfaces
 	service.periodicUpdater.SetOnUpdateTierPagesCallback(service.handleTierPagesUpdate)
 	service.periodicUpdater.SetOnUpdateMissingExtraIdsCallback(service.handleMissingExtraIdsUpdate)
 	service.periodicUpdater.SetOnInitialLoadCompletedCallback(service.handleInitialLoadCompleted)
+	service.periodicUpdater.SetOnTierRestoredCallback(service.handleTierRestored)
 
 	return service
 }
 
+// getConfig returns the current config
+func (s *Service) getConfig() *cfg.Config {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+	return s.config
+}
+
+// ApplyConfig implements core.IReloadable: tiers, intervals, TTL and params normalization are applied live
+func (s *Service) ApplyConfig(config *cfg.Config) {
+	s.configMu.Lock()
+	s.config = config
+	s.configMu.Unlock()
+
+	s.periodicUpdater.SetConfig(&config.CoingeckoMarkets)
+}
+
+// SetSnapshotStore enables warm-start snapshots of markets tiers
+func (s *Service) SetSnapshotStore(store *snapshot.Store) {
+	s.periodicUpdater.SetSnapshotStore(store)
+}
+
 // handleTierPagesUpdate handles tier pages update by caching tokens and emitting events
 func (s *Service) handleTierPagesUpdate(ctx context.Context, tier cfg.MarketTier, pagesData []PageData) {
+	s.updateTierPages(ctx, tier, pagesData, s.getConfig().CoingeckoMarkets.GetTTL(), false)
+}
+
+// handleTierRestored caches tier pages restored from snapshot with their remaining TTL
+// and emits an event marked as restored
+func (s *Service) handleTierRestored(ctx context.Context, tier cfg.MarketTier, pagesData []PageData, updatedAt time.Time) {
+	ttl := s.getConfig().CoingeckoMarkets.GetTTL() - time.Since(updatedAt)
+	if ttl <= 0 {
+		return
+	}
+	s.updateTierPages(ctx, tier, pagesData, ttl, true)
+}
+
+// updateTierPages caches tier pages with ttl, updates top IDs and emits events
+func (s *Service) updateTierPages(ctx context.Context, tier cfg.MarketTier, pagesData []PageData, ttl time.Duration, restored bool) {
 	// ICache by individual ids
+	var updatedIds []string
 	for _, pageData := range pagesData {
-		_, err := s.cacheTokensByID(pageData.Data)
+		marketData, err := s.cacheTokensByID(pageData.Data, ttl)
 		if err != nil {
 			log.Printf("Failed to cache markets data by id: %v", err)
 		}
+		updatedIds = append(updatedIds, extractTokenIDs(marketData)...)
 	}
 
 	// ICache pages
-	_, err := s.cacheTokensPage(tier, pagesData)
+	_, err := s.cacheTokensPage(tier, pagesData, ttl)
 	if err != nil {
 		log.Printf("Failed to cache page data: %v", err)
 	}
 
-	// Update top IDs with new pages data
-	s.topIdsManager.UpdatePagesFromPageData(pagesData)
+	// ICache sparklines by individual ids
+	if err := s.cacheSparklines(pagesData, ttl); err != nil {
+		log.Printf("Failed to cache sparkline data: %v", err)
+	}
+
+	// Update top IDs with new pages data, category pages don't affect top markets
+	if tier.Category == "" {
+		s.topIdsManager.UpdatePagesFromPageData(pagesData)
+	}
 
 	log.Printf("Markets service cache update complete - pages %d", len(pagesData))
-	s.subscriptionManager.Emit(ctx)
+	s.publishUpdate(ctx, tier.Name, updatedIds, restored)
 }
 
 // handleMissingExtraIdsUpdate handles missing extra IDs update by caching tokens and emitting events
 func (s *Service) handleMissingExtraIdsUpdate(ctx context.Context, tokensData [][]byte) {
-	_, err := s.cacheTokensByID(tokensData)
+	marketData, err := s.cacheTokensByID(tokensData, s.getConfig().CoingeckoMarkets.GetTTL())
 	if err != nil {
 		log.Printf("Failed to cache missing extra IDs: %v", err)
 	}
 
 	log.Printf("Markets service cache update complete - extra ids: %d", len(tokensData))
-	s.subscriptionManager.Emit(ctx)
+	if updatedIds := extractTokenIDs(marketData); len(updatedIds) > 0 {
+		s.publishUpdate(ctx, "", updatedIds, false)
+	}
+}
+
+// publishUpdate notifies subscribers about updated markets data of token IDs,
+// restored marks data restored from snapshot. Empty ids notify that everything may have changed
+func (s *Service) publishUpdate(ctx context.Context, tierName string, ids []string, restored bool) {
+	s.subscriptionManager.Publish(ctx, events.Event{Tier: tierName, IDs: ids, Restored: restored})
 }
 
 // handleInitialLoadCompleted handles initial load completion by emitting initialization event
@@ -108,12 +169,45 @@ func (s *Service) onTokenListChanged() {
 	}
 }
 
+// TierStatuses implements interfaces.ITiersAdmin
+func (s *Service) TierStatuses() []interfaces.TierStatus {
+	return s.periodicUpdater.TierStatuses()
+}
+
+// RefreshAll implements interfaces.ITiersAdmin
+func (s *Service) RefreshAll() error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	s.periodicUpdater.ForceUpdate(s.ctx)
+	return nil
+}
+
+// RefreshTier implements interfaces.ITiersAdmin
+func (s *Service) RefreshTier(name string) error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	return s.periodicUpdater.RefreshTier(s.ctx, name)
+}
+
+// RefreshID implements interfaces.ITiersAdmin
+func (s *Service) RefreshID(id string) error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	s.periodicUpdater.RefreshID(s.ctx, id)
+	return nil
+}
+
 // Start implements core.Interface
 func (s *Service) Start(ctx context.Context) error {
 	if s.cache == nil {
 		return fmt.Errorf("cache dependency not provided")
 	}
 
+	s.ctx = ctx
+
 	if s.tokensService != nil {
 		s.tokenUpdateSubscription = s.tokensService.SubscribeOnTokensUpdate().
 			Watch(ctx, s.onTokenListChanged, true)
@@ -144,15 +238,15 @@ func (s *Service) Stop() {
 	}
 }
 
-// cacheTokensByID parses tokens data and caches each token by its CoinGecko ID
-func (s *Service) cacheTokensByID(tokensData [][]byte) ([]interface{}, error) {
+// cacheTokensByID parses tokens data and caches each token by its CoinGecko ID for ttl
+func (s *Service) cacheTokensByID(tokensData [][]byte, ttl time.Duration) ([]interface{}, error) {
 	marketData, cacheData, err := parseTokensData(tokensData)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(cacheData) > 0 {
-		err := s.cache.Set(cacheData, s.config.CoingeckoMarkets.GetTTL())
+		err := s.cache.Set(cacheData, ttl)
 		if err != nil {
 			log.Printf("Failed to cache tokens data: %v", err)
 			return nil, fmt.Errorf("failed to cache tokens data: %w", err)
@@ -162,15 +256,15 @@ func (s *Service) cacheTokensByID(tokensData [][]byte) ([]interface{}, error) {
 	return marketData, nil
 }
 
-// cacheTokensPage caches page data for page-based requests
-func (s *Service) cacheTokensPage(tier cfg.MarketTier, pagesData []PageData) (map[int]interface{}, error) {
-	pageMapping, cacheData, err := parsePagesData(pagesData)
+// cacheTokensPage caches page data for page-based requests for ttl
+func (s *Service) cacheTokensPage(tier cfg.MarketTier, pagesData []PageData, ttl time.Duration) (map[int]interface{}, error) {
+	pageMapping, cacheData, err := parsePagesData(tier.Category, pagesData)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(cacheData) > 0 {
-		err := s.cache.Set(cacheData, s.config.CoingeckoMarkets.GetTTL())
+		err := s.cache.Set(cacheData, ttl)
 		if err != nil {
 			log.Printf("Failed to cache page data: %v", err)
 			return nil, fmt.Errorf("failed to cache page data: %w", err)
@@ -183,10 +277,23 @@ func (s *Service) cacheTokensPage(tier cfg.MarketTier, pagesData []PageData) (ma
 // Markets fetches markets data using cache with specified parameters
 // Returns full CoinGecko markets response in APIResponse format
 func (s *Service) Markets(params interfaces.MarketsParams) (interfaces.MarketsResponse, interfaces.CacheStatus, error) {
+	response, cacheStatus, err := s.marketsData(params)
+	if err == nil && params.SparklineEnabled {
+		s.mergeSparklines(response)
+	}
+	return response, cacheStatus, err
+}
+
+// marketsData returns cached markets data without sparklines
+func (s *Service) marketsData(params interfaces.MarketsParams) (interfaces.MarketsResponse, interfaces.CacheStatus, error) {
 	if len(params.IDs) > 0 {
 		return s.MarketsByIds(params)
 	}
 
+	if !s.isCachedPageRequest(params) {
+		return s.MarketsSorted(params)
+	}
+
 	if params.Page > 0 {
 		return s.MarketsByPage(params.Page, params.Page, params)
 	}
@@ -234,10 +341,38 @@ func (s *Service) MarketsByIds(params interfaces.MarketsParams) (response interf
 		log.Printf("Cache miss for %d requested tokens", len(cacheKeys))
 	}
 
-	return interfaces.MarketsResponse(marketData), cacheStatus, nil
+	return interfaces.MarketsResponse(marketData), s.markStaleRestoredData(cacheStatus), nil
+}
+
+// markStaleRestoredData downgrades cache status while data restored from snapshot is being refreshed
+func (s *Service) markStaleRestoredData(cacheStatus interfaces.CacheStatus) interfaces.CacheStatus {
+	if cacheStatus != interfaces.CacheStatusMiss && s.periodicUpdater != nil && s.periodicUpdater.HasStaleRestoredData() {
+		return interfaces.CacheStatusStale
+	}
+	return cacheStatus
+}
+
+// UpdatedAt returns the last update time and update interval of the tier serving the request:
+// the tier holding the requested page for cached page requests, otherwise the least recently
+// updated tier of the category. Zero time is returned if no tier has data.
+func (s *Service) UpdatedAt(params interfaces.MarketsParams) (time.Time, time.Duration) {
+	page := 0
+	category := params.Category
+	if len(params.IDs) > 0 {
+		category = ""
+	} else if s.isCachedPageRequest(params) {
+		page = params.Page
+	}
+
+	updatedAt, interval, ok := s.periodicUpdater.TierUpdate(category, page)
+	if !ok {
+		return time.Time{}, 0
+	}
+	return updatedAt, interval
 }
 
-// MarketsByPage fetches markets data for a specific page range using cache only
+// MarketsByPage fetches markets data for a specific page range using cache only.
+// Pages of params.Category are returned, they are cached only for configured category tiers.
 func (s *Service) MarketsByPage(pageFrom, pageTo int, params interfaces.MarketsParams) (interfaces.MarketsResponse, interfaces.CacheStatus, error) {
 	// Validate page range
 	if pageFrom <= 0 || pageTo <= 0 || pageFrom > pageTo {
@@ -246,7 +381,7 @@ func (s *Service) MarketsByPage(pageFrom, pageTo int, params interfaces.MarketsP
 
 	pageCacheKeys := make([]string, 0, pageTo-pageFrom+1)
 	for page := pageFrom; page <= pageTo; page++ {
-		pageCacheKeys = append(pageCacheKeys, createPageCacheKey(page))
+		pageCacheKeys = append(pageCacheKeys, createPageCacheKey(params.Category, page))
 	}
 
 	cachedData, missingKeys, err := s.cache.Get(pageCacheKeys)
@@ -257,7 +392,7 @@ func (s *Service) MarketsByPage(pageFrom, pageTo int, params interfaces.MarketsP
 
 	var allMarketData []interface{}
 	for page := pageFrom; page <= pageTo; page++ {
-		pageCacheKey := createPageCacheKey(page)
+		pageCacheKey := createPageCacheKey(params.Category, page)
 		if pageBytes, exists := cachedData[pageCacheKey]; exists {
 			// Page data is stored as [][]byte, not []interface{}
 			var pageDataBytes [][]byte
@@ -286,12 +421,12 @@ func (s *Service) MarketsByPage(pageFrom, pageTo int, params interfaces.MarketsP
 		cacheStatus = interfaces.CacheStatusMiss
 	}
 
-	return interfaces.MarketsResponse(allMarketData), cacheStatus, nil
+	return interfaces.MarketsResponse(allMarketData), s.markStaleRestoredData(cacheStatus), nil
 }
 
 // getMaxTokenLimit calculates the maximum token limit from tiers configuration
 func (s *Service) getMaxTokenLimit() int {
-	if s.config == nil {
+	if s.getConfig() == nil {
 		return MARKETS_DEFAULT_CHUNK_SIZE
 	}
 
@@ -305,8 +440,8 @@ func (s *Service) getMaxTokenLimit() int {
 	perPage := params.PerPage
 
 	maxPageTo := 0
-	for _, tier := range s.config.CoingeckoMarkets.Tiers {
-		if tier.PageTo > maxPageTo {
+	for _, tier := range s.getConfig().CoingeckoMarkets.Tiers {
+		if tier.Category == "" && tier.PageTo > maxPageTo {
 			maxPageTo = tier.PageTo
 		}
 	}
@@ -349,6 +484,7 @@ func (s *Service) TopMarkets(limit int, currency string) (interfaces.MarketsResp
 		PerPage:  MARKETS_DEFAULT_CHUNK_SIZE,
 	}
 	params = s.getParamsOverride(params)
+	params.Category = ""
 	perPage := params.PerPage
 
 	pageTo := (limit + perPage - 1) / perPage
@@ -386,6 +522,11 @@ func (s *Service) TopMarketIds(limit int) ([]string, error) {
 	return topIds, nil
 }
 
+// CachedCategories returns categories which have configured tiers and are served from cache
+func (s *Service) CachedCategories() []string {
+	return s.getConfig().CoingeckoMarkets.GetCategories()
+}
+
 func (s *Service) SubscribeTopMarketsUpdate() events.ISubscription {
 	return s.subscriptionManager.Subscribe()
 }
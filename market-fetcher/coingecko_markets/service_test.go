@@ -395,7 +395,7 @@ func TestService_cacheTokensByID(t *testing.T) {
 				mockCache.EXPECT().Set(gomock.Any(), gomock.Any()).Return(tt.cacheSetError)
 			}
 
-			marketData, err := service.cacheTokensByID(tt.tokensData)
+			marketData, err := service.cacheTokensByID(tt.tokensData, time.Minute)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -902,3 +902,42 @@ func TestMarketsByPageAndMarketsByIdsReturnSameFormat(t *testing.T) {
 		}
 	})
 }
+
+func TestService_CategoryTierPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := createTestConfig()
+	categoryTier := config.MarketTier{Name: "layer-2", Category: "layer-2", PageFrom: 1, PageTo: 1, UpdateInterval: time.Minute}
+	cfg.CoingeckoMarkets.Tiers = append(cfg.CoingeckoMarkets.Tiers, categoryTier)
+
+	service := NewService(cache.NewService(cache.DefaultCacheConfig()), cfg, createMockTokensService(ctrl))
+	assert.Equal(t, []string{"layer-2"}, service.CachedCategories())
+
+	service.handleTierPagesUpdate(context.Background(), cfg.CoingeckoMarkets.Tiers[0], []PageData{
+		{Page: 1, Data: [][]byte{sampleMarketData1}},
+	})
+	service.handleTierPagesUpdate(context.Background(), categoryTier, []PageData{
+		{Page: 1, Data: [][]byte{sampleMarketData2}},
+	})
+
+	// Category pages are served for the requested category only
+	response, cacheStatus, err := service.Markets(interfaces.MarketsParams{Page: 1, Category: "layer-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusFull, cacheStatus)
+	assert.Equal(t, []string{"ethereum"}, extractTokenIDs(response))
+
+	response, _, err = service.Markets(interfaces.MarketsParams{Page: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bitcoin"}, extractTokenIDs(response))
+
+	response, cacheStatus, err = service.Markets(interfaces.MarketsParams{Page: 1, Category: "meme-token"})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusMiss, cacheStatus)
+	assert.Empty(t, response)
+
+	// Category pages don't change top markets
+	topIds, err := service.TopMarketIds(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bitcoin"}, topIds)
+}
@@ -0,0 +1,110 @@
+package coingecko_markets
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// SPARKLINE_FIELD is the markets field with 7d price sparkline data
+const SPARKLINE_FIELD = "sparkline_in_7d"
+
+// splitSparklines removes sparkline data from tokens of the page and returns it in PageData.Sparklines
+func splitSparklines(pageData PageData) PageData {
+	result := PageData{Page: pageData.Page, Data: make([][]byte, 0, len(pageData.Data))}
+
+	for _, tokenBytes := range pageData.Data {
+		var tokenMap map[string]json.RawMessage
+		if err := json.Unmarshal(tokenBytes, &tokenMap); err != nil {
+			result.Data = append(result.Data, tokenBytes)
+			continue
+		}
+
+		sparkline, exists := tokenMap[SPARKLINE_FIELD]
+		if !exists {
+			result.Data = append(result.Data, tokenBytes)
+			continue
+		}
+
+		var tokenID string
+		_ = json.Unmarshal(tokenMap[ID_FIELD], &tokenID)
+
+		delete(tokenMap, SPARKLINE_FIELD)
+		strippedBytes, err := json.Marshal(tokenMap)
+		if err != nil {
+			result.Data = append(result.Data, tokenBytes)
+			continue
+		}
+		result.Data = append(result.Data, strippedBytes)
+
+		if tokenID != "" {
+			if result.Sparklines == nil {
+				result.Sparklines = make(map[string][]byte)
+			}
+			result.Sparklines[tokenID] = sparkline
+		}
+	}
+
+	return result
+}
+
+// cacheSparklines caches sparkline data of pages by token ID for ttl
+func (s *Service) cacheSparklines(pagesData []PageData, ttl time.Duration) error {
+	cacheData := make(map[string][]byte)
+	for _, pageData := range pagesData {
+		for tokenID, sparkline := range pageData.Sparklines {
+			cacheData[getSparklineCacheKey(tokenID)] = sparkline
+		}
+	}
+
+	if len(cacheData) == 0 {
+		return nil
+	}
+
+	if err := s.cache.Set(cacheData, ttl); err != nil {
+		return fmt.Errorf("failed to cache sparkline data: %w", err)
+	}
+	return nil
+}
+
+// mergeSparklines adds cached sparkline data to markets response tokens.
+// Tokens without cached sparkline get an empty price list like CoinGecko returns for coins without history.
+func (s *Service) mergeSparklines(response []interface{}) {
+	tokenMaps := make(map[string]map[string]interface{}, len(response))
+	keys := make([]string, 0, len(response))
+	for _, tokenData := range response {
+		tokenMap, ok := tokenData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tokenID := getStringFromMap(tokenMap, ID_FIELD)
+		if tokenID == "" {
+			continue
+		}
+		tokenMap[SPARKLINE_FIELD] = map[string]interface{}{"price": []interface{}{}}
+		tokenMaps[tokenID] = tokenMap
+		keys = append(keys, getSparklineCacheKey(tokenID))
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	cachedData, _, err := s.cache.Get(keys)
+	if err != nil {
+		log.Printf("Failed to get sparkline data from cache: %v", err)
+		return
+	}
+
+	for tokenID, tokenMap := range tokenMaps {
+		sparklineBytes, exists := cachedData[getSparklineCacheKey(tokenID)]
+		if !exists {
+			continue
+		}
+		var sparkline interface{}
+		if err := json.Unmarshal(sparklineBytes, &sparkline); err == nil {
+			tokenMap[SPARKLINE_FIELD] = sparkline
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package coingecko_markets
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/status-im/market-proxy/cache"
+	api_mocks "github.com/status-im/market-proxy/coingecko_markets/mocks"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+func TestSplitSparklines(t *testing.T) {
+	pageData := splitSparklines(PageData{Page: 3, Data: [][]byte{
+		[]byte(`{"id":"bitcoin","current_price":45000,"sparkline_in_7d":{"price":[1,2,3]}}`),
+		[]byte(`{"id":"ethereum","current_price":3000}`),
+		[]byte(`not json`),
+	}})
+
+	assert.Equal(t, 3, pageData.Page)
+	require.Len(t, pageData.Data, 3)
+	assert.JSONEq(t, `{"id":"bitcoin","current_price":45000}`, string(pageData.Data[0]))
+	assert.Equal(t, `{"id":"ethereum","current_price":3000}`, string(pageData.Data[1]))
+	assert.Equal(t, `not json`, string(pageData.Data[2]))
+	assert.Equal(t, map[string][]byte{"bitcoin": []byte(`{"price":[1,2,3]}`)}, pageData.Sparklines)
+}
+
+func TestPeriodicUpdater_SparklineTier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sparkline := false
+	cfg := createTestPeriodicUpdaterConfig()
+	cfg.MarketParamsNormalize.Sparkline = &sparkline
+	mockFetcher := api_mocks.NewMockIAPIClient(ctrl)
+	updater := NewPeriodicUpdater(cfg, mockFetcher)
+
+	mockFetcher.EXPECT().FetchPage(gomock.Any()).DoAndReturn(func(params interfaces.MarketsParams) ([][]byte, error) {
+		assert.True(t, params.SparklineEnabled)
+		return [][]byte{[]byte(`{"id":"bitcoin","sparkline_in_7d":{"price":[1,2]}}`)}, nil
+	})
+
+	var mu sync.Mutex
+	var updatedPages []PageData
+	updater.SetOnUpdateTierPagesCallback(func(ctx context.Context, tier config.MarketTier, pagesData []PageData) {
+		mu.Lock()
+		defer mu.Unlock()
+		updatedPages = pagesData
+	})
+
+	tier := config.MarketTier{Name: "top", PageFrom: 1, PageTo: 1, UpdateInterval: time.Minute, Sparkline: true}
+	require.NoError(t, updater.fetchAndUpdateTier(context.Background(), tier))
+
+	// Tier data doesn't keep sparklines, they are passed with pages
+	tierData := updater.GetCacheDataForTier(tier.Name)
+	require.Len(t, tierData.Data, 1)
+	assert.JSONEq(t, `{"id":"bitcoin"}`, string(tierData.Data[0].Raw))
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, updatedPages, 1)
+	assert.Equal(t, []byte(`{"price":[1,2]}`), updatedPages[0].Sparklines["bitcoin"])
+}
+
+func TestService_MarketsSparkline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := createTestConfig()
+	service := NewService(cache.NewService(cache.DefaultCacheConfig()), cfg, createMockTokensService(ctrl))
+
+	service.handleTierPagesUpdate(context.Background(), cfg.CoingeckoMarkets.Tiers[0], []PageData{{
+		Page:       1,
+		Data:       [][]byte{sampleMarketData1, sampleMarketData2},
+		Sparklines: map[string][]byte{"bitcoin": []byte(`{"price":[44000,45000]}`)},
+	}})
+
+	t.Run("Sparkline is not included by default", func(t *testing.T) {
+		response, _, err := service.Markets(interfaces.MarketsParams{Page: 1})
+		require.NoError(t, err)
+		require.Len(t, response, 2)
+		assert.NotContains(t, response[0].(map[string]interface{}), SPARKLINE_FIELD)
+	})
+
+	t.Run("Sparkline is merged when requested", func(t *testing.T) {
+		for _, params := range []interfaces.MarketsParams{
+			{Page: 1, SparklineEnabled: true},
+			{IDs: []string{"bitcoin", "ethereum"}, SparklineEnabled: true},
+		} {
+			response, _, err := service.Markets(params)
+			require.NoError(t, err)
+			require.Len(t, response, 2)
+
+			bitcoin := response[0].(map[string]interface{})
+			assert.Equal(t, map[string]interface{}{"price": []interface{}{44000.0, 45000.0}}, bitcoin[SPARKLINE_FIELD])
+
+			// Tokens without cached sparkline get an empty one
+			ethereum := response[1].(map[string]interface{})
+			assert.Equal(t, map[string]interface{}{"price": []interface{}{}}, ethereum[SPARKLINE_FIELD])
+		}
+	})
+}
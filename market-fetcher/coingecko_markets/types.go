@@ -1,6 +1,9 @@
 package coingecko_markets
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Original CoinGecko response structure
 type CoinGeckoData struct {
@@ -30,6 +33,8 @@ type CoinGeckoData struct {
 	ATLDate                      string      `json:"atl_date"`
 	ROI                          interface{} `json:"roi"`
 	LastUpdated                  string      `json:"last_updated"`
+
+	Raw json.RawMessage `json:"-"` // Original item of the markets response
 }
 
 // APIResponse represents the full response structure with all CoinGecko data
@@ -42,4 +47,6 @@ type TierDataWithTimestamp struct {
 	Data            []CoinGeckoData `json:"data"`
 	Timestamp       time.Time       `json:"timestamp"`         // Last successful update time
 	UpdateStartTime *time.Time      `json:"update_start_time"` // Time when current update started (nil if not updating)
+	Pages           []PageData      `json:"-"`                 // Raw pages data used for snapshots
+	Restored        bool            `json:"restored"`          // Data was restored from snapshot and not refreshed yet
 }
@@ -17,6 +17,9 @@ const (
 
 	// CACHE_KEY_PAGE_IDS_PREFIX is the prefix used for page-based token IDs cache keys
 	CACHE_KEY_PAGE_IDS_PREFIX = "markets_page_ids:"
+
+	// CACHE_KEY_SPARKLINE_PREFIX is the prefix used for sparkline data cache keys
+	CACHE_KEY_SPARKLINE_PREFIX = "markets_sparkline:"
 )
 
 // createCacheKeys creates cache keys for each token ID in MarketParams
@@ -63,38 +66,54 @@ func extractTokenIDFromKey(key string) string {
 	return ""
 }
 
+// extractTokenIDs extracts token IDs from parsed markets data
+func extractTokenIDs(marketData []interface{}) []string {
+	ids := make([]string, 0, len(marketData))
+	for _, tokenData := range marketData {
+		if tokenMap, ok := tokenData.(map[string]interface{}); ok {
+			if id := getStringFromMap(tokenMap, ID_FIELD); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
 // getCacheKey creates a cache key for a single token ID
 func getCacheKey(tokenID string) string {
 	return fmt.Sprintf("%s%s", CACHE_KEY_PREFIX, tokenID)
 }
 
+// getSparklineCacheKey creates a sparkline data cache key for a single token ID
+func getSparklineCacheKey(tokenID string) string {
+	return fmt.Sprintf("%s%s", CACHE_KEY_SPARKLINE_PREFIX, tokenID)
+}
+
 // createPageCacheKey creates a single cache key for page-based requests
-func createPageCacheKey(pageID int) string {
+// Pages of top markets (empty category) keep the "markets_page:{page}" format
+func createPageCacheKey(category string, pageID int) string {
+	if category != "" {
+		return fmt.Sprintf("%s%s:%d", CACHE_KEY_PAGE_PREFIX, category, pageID)
+	}
 	return fmt.Sprintf("%s%d", CACHE_KEY_PAGE_PREFIX, pageID)
 }
 
 // createPageIdsCacheKey creates a cache key for page IDs
-func createPageIdsCacheKey(pageID int) string {
+func createPageIdsCacheKey(category string, pageID int) string {
+	if category != "" {
+		return fmt.Sprintf("%s%s:%d", CACHE_KEY_PAGE_IDS_PREFIX, category, pageID)
+	}
 	return fmt.Sprintf("%s%d", CACHE_KEY_PAGE_IDS_PREFIX, pageID)
 }
 
 // ConvertMarketsResponseToCoinGeckoData converts raw markets response data to CoinGeckoData slice
 // This function processes the [][]byte from coins/markets API, unmarshals each item, and converts to CoinGeckoData
 func ConvertMarketsResponseToCoinGeckoData(tokensData [][]byte) []CoinGeckoData {
-	// Convert raw markets data to []interface{}
-	marketsData := make([]interface{}, 0, len(tokensData))
-	for _, tokenBytes := range tokensData {
-		var tokenData interface{}
-		if err := json.Unmarshal(tokenBytes, &tokenData); err == nil {
-			marketsData = append(marketsData, tokenData)
-		}
-	}
-
-	result := make([]CoinGeckoData, 0, len(marketsData))
+	result := make([]CoinGeckoData, 0, len(tokensData))
 
-	for _, item := range marketsData {
-		itemMap, ok := item.(map[string]interface{})
-		if !ok {
+	for _, tokenBytes := range tokensData {
+		var itemMap map[string]interface{}
+		if err := json.Unmarshal(tokenBytes, &itemMap); err != nil || itemMap == nil {
 			continue
 		}
 
@@ -126,6 +145,7 @@ func ConvertMarketsResponseToCoinGeckoData(tokensData [][]byte) []CoinGeckoData
 			ATLDate:                      getStringFromMap(itemMap, "atl_date"),
 			ROI:                          itemMap["roi"], // Keep as interface{}
 			LastUpdated:                  getStringFromMap(itemMap, "last_updated"),
+			Raw:                          tokenBytes,
 		}
 
 		result = append(result, coinData)
@@ -0,0 +1,90 @@
+package coingecko_ohlc
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync/atomic"
+
+	cg "github.com/status-im/market-proxy/coingecko_common"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+type IAPIClient interface {
+	FetchOHLC(params OHLCParams) (OHLCResponse, error)
+	Healthy() bool
+}
+
+type CoinGeckoClient struct {
+	config          *config.Config
+	keyManager      cg.IAPIKeyManager
+	httpClient      *cg.HTTPClientWithRetries
+	successfulFetch atomic.Bool
+}
+
+func NewCoinGeckoClient(cfg *config.Config) *CoinGeckoClient {
+	retryOpts := cg.DefaultRetryOptions()
+	retryOpts.LogPrefix = "CoinGecko-OHLC"
+
+	metricsWriter := metrics.NewMetricsWriter(metrics.ServiceOHLC)
+
+	return &CoinGeckoClient{
+		config:     cfg,
+		keyManager: cg.NewAPIKeyManager(cfg.APITokens),
+		httpClient: cg.NewHTTPClientWithRetries(retryOpts, metricsWriter, cg.GetRateLimiterManagerInstance()),
+	}
+}
+
+func (c *CoinGeckoClient) Healthy() bool {
+	return c.successfulFetch.Load()
+}
+
+func (c *CoinGeckoClient) FetchOHLC(params OHLCParams) (OHLCResponse, error) {
+	if params.ID == "" {
+		return nil, fmt.Errorf("coin ID is required")
+	}
+
+	executor := func(apiKey cg.APIKey) (interface{}, bool, error) {
+		baseURL := cg.GetApiBaseUrl(c.config, apiKey.Type)
+
+		requestBuilder := NewOHLCRequestBuilder(baseURL, params.ID).
+			WithDays(params.Days).
+			WithInterval(params.Interval).
+			WithCurrency(params.Currency).
+			WithApiKey(apiKey.Key, apiKey.Type)
+
+		request, err := requestBuilder.Build()
+		if err != nil {
+			log.Printf("CoinGecko-OHLC: Error building request with key type %v: %v", apiKey.Type, err)
+			return nil, false, err
+		}
+
+		resp, body, _, err := c.httpClient.ExecuteRequest(request)
+		if err != nil {
+			return nil, false, err
+		}
+		resp.Body.Close()
+
+		var result OHLCResponse
+		if err := json.Unmarshal(body, &result); err != nil {
+			log.Printf("CoinGecko-OHLC: Error parsing JSON response: %v", err)
+			return nil, false, err
+		}
+
+		c.successfulFetch.Store(true)
+		return result, true, nil
+	}
+
+	onFailed := cg.CreateFailCallback(c.keyManager)
+	availableKeys := c.keyManager.GetAvailableKeys()
+
+	result, err := cg.TryWithKeys(availableKeys, "CoinGecko-OHLC", executor, onFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("CoinGecko-OHLC: Successfully fetched OHLC for coin %s", params.ID)
+
+	return result.(OHLCResponse), nil
+}
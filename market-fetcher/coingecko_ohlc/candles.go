@@ -0,0 +1,62 @@
+package coingecko_ohlc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// candleSizes maps explicit intervals to candle sizes used for local aggregation
+var candleSizes = map[string]time.Duration{
+	IntervalHourly: time.Hour,
+	IntervalDaily:  24 * time.Hour,
+}
+
+// BuildCandles aggregates [timestamp ms, price] points into candles of the given size.
+// Candle timestamp is its close time, the same as upstream. Points are expected to be sorted
+// by timestamp, candles without points are skipped.
+func BuildCandles(prices [][2]float64, size time.Duration) OHLCResponse {
+	sizeMs := float64(size.Milliseconds())
+	candles := make(OHLCResponse, 0)
+
+	for _, point := range prices {
+		timestamp, price := point[0], point[1]
+		closeTime := (float64(int64(timestamp/sizeMs)) + 1) * sizeMs
+
+		last := len(candles) - 1
+		if last >= 0 && candles[last][0] == closeTime {
+			candles[last][2] = max(candles[last][2], price)
+			candles[last][3] = min(candles[last][3], price)
+			candles[last][4] = price
+			continue
+		}
+
+		candles = append(candles, OHLCData{closeTime, price, price, price, price})
+	}
+
+	return candles
+}
+
+// parsePricePoints converts "prices" value of market chart response into sorted points
+func parsePricePoints(data interface{}) ([][2]float64, error) {
+	if data == nil {
+		return nil, fmt.Errorf("prices are missing in market chart data")
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var points [][2]float64
+	if err := json.Unmarshal(dataBytes, &points); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i][0] < points[j][0]
+	})
+
+	return points, nil
+}
@@ -0,0 +1,44 @@
+package coingecko_ohlc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCandles(t *testing.T) {
+	hour := float64(time.Hour.Milliseconds())
+	prices := [][2]float64{
+		{0, 100},
+		{hour * 0.25, 120},
+		{hour * 0.5, 80},
+		{hour * 0.75, 110},
+		{hour * 2.5, 130}, // no points in the second hour
+	}
+
+	candles := BuildCandles(prices, time.Hour)
+
+	assert.Equal(t, OHLCResponse{
+		{hour, 100, 120, 80, 110},
+		{hour * 3, 130, 130, 130, 130},
+	}, candles)
+}
+
+func TestBuildCandles_Empty(t *testing.T) {
+	candles := BuildCandles(nil, time.Hour)
+	assert.NotNil(t, candles)
+	assert.Empty(t, candles)
+}
+
+func TestParsePricePoints(t *testing.T) {
+	points, err := parsePricePoints([]interface{}{
+		[]interface{}{2000.0, 2.0},
+		[]interface{}{1000.0, 1.0},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][2]float64{{1000, 1}, {2000, 2}}, points)
+
+	_, err = parsePricePoints(nil)
+	assert.Error(t, err)
+}
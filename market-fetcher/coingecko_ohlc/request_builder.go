@@ -0,0 +1,44 @@
+package coingecko_ohlc
+
+import (
+	"fmt"
+
+	cg "github.com/status-im/market-proxy/coingecko_common"
+)
+
+const (
+	OHLC_API_PATH_TEMPLATE = "/api/v3/coins/%s/ohlc"
+)
+
+type OHLCRequestBuilder struct {
+	*cg.CoingeckoRequestBuilder
+	coinID string
+}
+
+func NewOHLCRequestBuilder(baseURL, coinID string) *OHLCRequestBuilder {
+	apiPath := fmt.Sprintf(OHLC_API_PATH_TEMPLATE, coinID)
+
+	rb := &OHLCRequestBuilder{
+		CoingeckoRequestBuilder: cg.NewCoingeckoRequestBuilder(baseURL, apiPath),
+		coinID:                  coinID,
+	}
+
+	rb.WithCurrency("usd")
+	rb.WithDays("30")
+
+	return rb
+}
+
+func (rb *OHLCRequestBuilder) WithDays(days string) *OHLCRequestBuilder {
+	if days != "" {
+		rb.With("days", days)
+	}
+	return rb
+}
+
+func (rb *OHLCRequestBuilder) WithInterval(interval string) *OHLCRequestBuilder {
+	if interval != "" {
+		rb.With("interval", interval)
+	}
+	return rb
+}
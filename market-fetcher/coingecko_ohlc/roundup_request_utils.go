@@ -0,0 +1,43 @@
+package coingecko_ohlc
+
+import (
+	"log"
+)
+
+// RoundUpOHLCParams rounds up the days parameter to get maximum data with the same candle size:
+// - Without interval 1 day keeps 30 minutes candles, 7-30 days are rounded up to 30 (4 hours candles)
+// and 90-365 days are rounded up to 365 (4 days candles)
+// - With hourly interval days are rounded up to 90, with daily interval to 180
+// - "max" is kept
+func RoundUpOHLCParams(params OHLCParams) OHLCParams {
+	roundedParams := params
+
+	daysInt, ok := params.daysInt()
+	if !ok {
+		return roundedParams
+	}
+
+	switch params.Interval {
+	case IntervalHourly:
+		if daysInt <= 90 {
+			roundedParams.Days = "90"
+		}
+	case IntervalDaily:
+		if daysInt <= 180 {
+			roundedParams.Days = "180"
+		}
+	default:
+		if daysInt > 30 {
+			roundedParams.Days = "365"
+		} else if daysInt > 2 {
+			roundedParams.Days = "30"
+		}
+	}
+
+	if params.Days != roundedParams.Days {
+		log.Printf("RoundUpOHLCParams: Rounded up days from %s to %s for coin %s to get maximum data",
+			params.Days, roundedParams.Days, params.ID)
+	}
+
+	return roundedParams
+}
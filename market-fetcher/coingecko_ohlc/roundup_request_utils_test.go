@@ -0,0 +1,38 @@
+package coingecko_ohlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundUpOHLCParams(t *testing.T) {
+	tests := []struct {
+		name         string
+		days         string
+		interval     string
+		expectedDays string
+	}{
+		{name: "Days 1 keeps 30 minutes candles", days: "1", expectedDays: "1"},
+		{name: "Days 7 should be rounded up to 30", days: "7", expectedDays: "30"},
+		{name: "Days 30 should stay 30", days: "30", expectedDays: "30"},
+		{name: "Days 90 should be rounded up to 365", days: "90", expectedDays: "365"},
+		{name: "Days 365 should stay 365", days: "365", expectedDays: "365"},
+		{name: "Max should stay max", days: "max", expectedDays: "max"},
+		{name: "Hourly interval is rounded up to 90", days: "7", interval: IntervalHourly, expectedDays: "90"},
+		{name: "Daily interval is rounded up to 180", days: "14", interval: IntervalDaily, expectedDays: "180"},
+		{name: "Daily interval keeps 365", days: "365", interval: IntervalDaily, expectedDays: "365"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := OHLCParams{ID: "bitcoin", Currency: "usd", Days: tt.days, Interval: tt.interval}
+			rounded := RoundUpOHLCParams(params)
+
+			assert.Equal(t, tt.expectedDays, rounded.Days)
+			assert.Equal(t, params.ID, rounded.ID)
+			assert.Equal(t, params.Currency, rounded.Currency)
+			assert.Equal(t, params.Interval, rounded.Interval)
+		})
+	}
+}
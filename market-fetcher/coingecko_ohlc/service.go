@@ -0,0 +1,213 @@
+package coingecko_ohlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/coingecko_market_chart"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+const (
+	OHLC_CACHE_PREFIX = "ohlc"
+)
+
+// ErrIntervalTooFine is returned for intervals finer than the market chart prices candles are built from
+var ErrIntervalTooFine = errors.New("interval is finer than the available price data")
+
+// IMarketChartProvider provides market chart price series used to build candles locally
+type IMarketChartProvider interface {
+	MarketChart(params coingecko_market_chart.MarketChartParams) (coingecko_market_chart.MarketChartResponseData, error)
+}
+
+type Service struct {
+	cache         cache.ICache
+	config        *config.Config
+	metricsWriter *metrics.MetricsWriter
+	apiClient     IAPIClient
+	marketChart   IMarketChartProvider // nil if candles can't be built locally
+}
+
+func NewService(cache cache.ICache, config *config.Config) *Service {
+	metricsWriter := metrics.NewMetricsWriter(metrics.ServiceOHLC)
+	apiClient := NewCoinGeckoClient(config)
+
+	return &Service{
+		cache:         cache,
+		config:        config,
+		metricsWriter: metricsWriter,
+		apiClient:     apiClient,
+	}
+}
+
+// SetMarketChartProvider enables building candles from market chart prices for intervals
+// which are not provided by the upstream plan
+func (s *Service) SetMarketChartProvider(marketChart IMarketChartProvider) {
+	s.marketChart = marketChart
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	if s.cache == nil {
+		return fmt.Errorf("cache dependency not provided")
+	}
+	return nil
+}
+
+func (s *Service) Stop() {
+}
+
+func (s *Service) OHLC(params OHLCParams) (OHLCResponse, error) {
+	log.Printf("Loading OHLC data for coin %s, currency=%s, days=%s, interval=%s",
+		params.ID, params.Currency, params.Days, params.Interval)
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	if params.Currency == "" {
+		params.Currency = "usd"
+	}
+	if params.Days == "" {
+		params.Days = "30"
+	}
+
+	if params.Interval != "" && !s.config.CoingeckoOHLC.HasPlanInterval(params.Interval) {
+		return s.ohlcFromMarketChart(params)
+	}
+
+	// Round up parameters to maximize cache utilization
+	roundedParams := RoundUpOHLCParams(params)
+
+	cacheKey := s.createCacheKey(roundedParams)
+
+	// Concurrent misses for the same candles share a single upstream fetch
+	loader := func(missingKeys []string) (map[string][]byte, error) {
+		log.Printf("ICache miss for OHLC %s, fetching from API with rounded params", params.ID)
+		candles, err := s.apiClient.FetchOHLC(roundedParams)
+		if err != nil {
+			log.Printf("apiClient.FetchOHLC failed: %v", err)
+			return nil, err
+		}
+
+		dataBytes, err := json.Marshal(candles)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{cacheKey: dataBytes}, nil
+	}
+
+	loadedData, err := s.cache.GetOrLoad([]string{cacheKey}, loader, true, s.selectTTL(roundedParams))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OHLC data: %w", err)
+	}
+
+	var candles OHLCResponse
+	if err := json.Unmarshal(loadedData[cacheKey], &candles); err != nil {
+		return nil, fmt.Errorf("failed to decode OHLC data: %w", err)
+	}
+
+	// Strip the data to match original request
+	return StripOHLCResponse(params, candles), nil
+}
+
+// ohlcFromMarketChart builds candles of the requested interval from market chart prices,
+// built candles are cached under the same key as upstream candles of the interval
+func (s *Service) ohlcFromMarketChart(params OHLCParams) (OHLCResponse, error) {
+	if s.marketChart == nil {
+		return nil, fmt.Errorf("interval %s is not available", params.Interval)
+	}
+
+	// Market chart prices are daily above the threshold, hourly candles would be single points
+	if params.Interval == IntervalHourly {
+		if days, ok := params.daysInt(); !ok || days > s.dailyDataThreshold() {
+			return nil, fmt.Errorf("invalid parameters: %w: hourly candles are available for up to %d days",
+				ErrIntervalTooFine, s.dailyDataThreshold())
+		}
+	}
+
+	cacheKey := s.createCacheKey(params)
+
+	loader := func(missingKeys []string) (map[string][]byte, error) {
+		chartData, err := s.marketChart.MarketChart(coingecko_market_chart.MarketChartParams{
+			ID:         params.ID,
+			Currency:   params.Currency,
+			Days:       params.Days,
+			DataFilter: "prices",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch market chart data: %w", err)
+		}
+
+		prices, err := parsePricePoints(chartData["prices"])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode market chart data: %w", err)
+		}
+
+		log.Printf("Building %s OHLC candles for coin %s from %d market chart prices",
+			params.Interval, params.ID, len(prices))
+
+		dataBytes, err := json.Marshal(BuildCandles(prices, candleSizes[params.Interval]))
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{cacheKey: dataBytes}, nil
+	}
+
+	loadedData, err := s.cache.GetOrLoad([]string{cacheKey}, loader, true, s.selectTTL(params))
+	if err != nil {
+		return nil, err
+	}
+
+	var candles OHLCResponse
+	if err := json.Unmarshal(loadedData[cacheKey], &candles); err != nil {
+		return nil, fmt.Errorf("failed to decode OHLC data: %w", err)
+	}
+
+	return candles, nil
+}
+
+// dailyDataThreshold returns the number of days above which market chart prices are daily
+func (s *Service) dailyDataThreshold() int {
+	if threshold := s.config.CoingeckoMarketChart.DailyDataThreshold; threshold > 0 {
+		return threshold
+	}
+	return config.GetDefaultMarketChartConfig().DailyDataThreshold
+}
+
+// Healthy is reported through the upstream client, candles are fetched on demand
+func (s *Service) Healthy() bool {
+	if s.apiClient != nil {
+		return s.apiClient.Healthy()
+	}
+	return false
+}
+
+func (s *Service) createCacheKey(params OHLCParams) string {
+	key := fmt.Sprintf("%s:%s:%s:days:%s", OHLC_CACHE_PREFIX, params.ID, params.Currency, params.Days)
+
+	if params.Interval != "" {
+		key += fmt.Sprintf(":interval:%s", params.Interval)
+	}
+
+	return key
+}
+
+// selectTTL returns the TTL by candle size: hourly TTL for candles of up to 4 hours, daily TTL otherwise
+func (s *Service) selectTTL(params OHLCParams) time.Duration {
+	if params.Interval == IntervalHourly {
+		return s.config.CoingeckoOHLC.GetHourlyTTL()
+	}
+	if params.Interval == "" {
+		if days, ok := params.daysInt(); ok && days <= 30 {
+			return s.config.CoingeckoOHLC.GetHourlyTTL()
+		}
+	}
+
+	return s.config.CoingeckoOHLC.GetDailyTTL()
+}
@@ -0,0 +1,174 @@
+package coingecko_ohlc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/coingecko_market_chart"
+	"github.com/status-im/market-proxy/config"
+)
+
+// MockIAPIClient implements IAPIClient for testing
+type MockIAPIClient struct {
+	mock.Mock
+}
+
+func (m *MockIAPIClient) FetchOHLC(params OHLCParams) (OHLCResponse, error) {
+	args := m.Called(params)
+	return args.Get(0).(OHLCResponse), args.Error(1)
+}
+
+func (m *MockIAPIClient) Healthy() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+// MockMarketChartProvider implements IMarketChartProvider for testing
+type MockMarketChartProvider struct {
+	mock.Mock
+}
+
+func (m *MockMarketChartProvider) MarketChart(params coingecko_market_chart.MarketChartParams) (coingecko_market_chart.MarketChartResponseData, error) {
+	args := m.Called(params)
+	return args.Get(0).(coingecko_market_chart.MarketChartResponseData), args.Error(1)
+}
+
+func createTestService(cfg *config.Config) (*Service, *MockIAPIClient) {
+	service := NewService(cache.NewService(cache.DefaultCacheConfig()), cfg)
+	mockClient := new(MockIAPIClient)
+	service.apiClient = mockClient
+	return service, mockClient
+}
+
+func TestService_StartWithoutCache(t *testing.T) {
+	service := NewService(nil, &config.Config{})
+
+	err := service.Start(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cache dependency not provided")
+}
+
+func TestService_OHLC_CachesRoundedRequest(t *testing.T) {
+	service, mockClient := createTestService(&config.Config{})
+
+	candles := createTestCandles(30, 4*time.Hour)
+	roundedParams := OHLCParams{ID: "bitcoin", Currency: "usd", Days: "30"}
+	mockClient.On("FetchOHLC", roundedParams).Return(candles, nil).Once()
+
+	result, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: "7"})
+	assert.NoError(t, err)
+	assert.Len(t, result, 7*6)
+
+	// 14 days are rounded up to the same 30 days request and served from cache
+	result, err = service.OHLC(OHLCParams{ID: "bitcoin", Currency: "usd", Days: "14"})
+	assert.NoError(t, err)
+	assert.Len(t, result, 14*6)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestService_OHLC_InvalidParams(t *testing.T) {
+	service, mockClient := createTestService(&config.Config{})
+
+	_, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: "5"})
+	assert.ErrorContains(t, err, "invalid parameters")
+
+	_, err = service.OHLC(OHLCParams{ID: "bitcoin", Interval: "5m"})
+	assert.ErrorContains(t, err, "invalid parameters")
+
+	_, err = service.OHLC(OHLCParams{Days: "1"})
+	assert.ErrorContains(t, err, "invalid parameters")
+
+	mockClient.AssertNotCalled(t, "FetchOHLC", mock.Anything)
+}
+
+func TestService_OHLC_PlanInterval(t *testing.T) {
+	cfg := &config.Config{CoingeckoOHLC: config.OHLCFetcherConfig{PlanIntervals: []string{IntervalDaily}}}
+	service, mockClient := createTestService(cfg)
+
+	candles := createTestCandles(180, 24*time.Hour)
+	mockClient.On("FetchOHLC", OHLCParams{ID: "bitcoin", Currency: "usd", Days: "180", Interval: IntervalDaily}).
+		Return(candles, nil).Once()
+
+	result, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: "30", Interval: IntervalDaily})
+	assert.NoError(t, err)
+	assert.Len(t, result, 30)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestService_OHLC_BuiltFromMarketChart(t *testing.T) {
+	service, mockClient := createTestService(&config.Config{})
+
+	// Without market chart provider intervals missing in the plan are not available
+	_, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: "7", Interval: IntervalDaily})
+	assert.ErrorContains(t, err, "not available")
+
+	day := float64((24 * time.Hour).Milliseconds())
+	marketChart := new(MockMarketChartProvider)
+	marketChart.On("MarketChart", coingecko_market_chart.MarketChartParams{
+		ID: "bitcoin", Currency: "usd", Days: "7", DataFilter: "prices",
+	}).Return(coingecko_market_chart.MarketChartResponseData{
+		"prices": []interface{}{
+			[]interface{}{day * 100, 10.0},
+			[]interface{}{day*100 + 1000, 12.0},
+			[]interface{}{day * 101, 11.0},
+		},
+	}, nil)
+	service.SetMarketChartProvider(marketChart)
+
+	expected := OHLCResponse{
+		{day * 101, 10, 12, 10, 12},
+		{day * 102, 11, 11, 11, 11},
+	}
+	result, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: "7", Interval: IntervalDaily})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	// Built candles are cached
+	result, err = service.OHLC(OHLCParams{ID: "bitcoin", Days: "7", Interval: IntervalDaily})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	marketChart.AssertNumberOfCalls(t, "MarketChart", 1)
+
+	mockClient.AssertNotCalled(t, "FetchOHLC", mock.Anything)
+}
+
+func TestService_OHLC_IntervalFinerThanMarketChart(t *testing.T) {
+	service, _ := createTestService(&config.Config{})
+	marketChart := new(MockMarketChartProvider)
+	service.SetMarketChartProvider(marketChart)
+
+	for _, days := range []string{"180", "max"} {
+		_, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: days, Interval: IntervalHourly})
+		assert.ErrorIs(t, err, ErrIntervalTooFine)
+		assert.ErrorContains(t, err, "invalid parameters")
+	}
+
+	marketChart.AssertNotCalled(t, "MarketChart", mock.Anything)
+}
+
+func TestService_OHLC_UpstreamError(t *testing.T) {
+	service, mockClient := createTestService(&config.Config{})
+	mockClient.On("FetchOHLC", mock.Anything).Return(OHLCResponse(nil), fmt.Errorf("upstream error"))
+
+	_, err := service.OHLC(OHLCParams{ID: "bitcoin", Days: "1"})
+	assert.ErrorContains(t, err, "failed to fetch OHLC data")
+}
+
+func TestService_SelectTTL(t *testing.T) {
+	cfg := &config.Config{CoingeckoOHLC: config.OHLCFetcherConfig{HourlyTTL: time.Minute, DailyTTL: time.Hour}}
+	service, _ := createTestService(cfg)
+
+	assert.Equal(t, time.Minute, service.selectTTL(OHLCParams{Days: "30"}))
+	assert.Equal(t, time.Hour, service.selectTTL(OHLCParams{Days: "365"}))
+	assert.Equal(t, time.Hour, service.selectTTL(OHLCParams{Days: "max"}))
+	assert.Equal(t, time.Minute, service.selectTTL(OHLCParams{Days: "180", Interval: IntervalHourly}))
+	assert.Equal(t, time.Hour, service.selectTTL(OHLCParams{Days: "7", Interval: IntervalDaily}))
+}
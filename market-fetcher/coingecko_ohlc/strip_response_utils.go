@@ -0,0 +1,24 @@
+package coingecko_ohlc
+
+import (
+	"time"
+)
+
+// StripOHLCResponse filters candles of rounded response to match original request days
+func StripOHLCResponse(originalParams OHLCParams, roundedResponse OHLCResponse) OHLCResponse {
+	days, ok := originalParams.daysInt()
+	if !ok {
+		return roundedResponse
+	}
+
+	cutoffTimestamp := float64(time.Now().AddDate(0, 0, -days).UnixMilli())
+
+	filtered := make(OHLCResponse, 0, len(roundedResponse))
+	for _, candle := range roundedResponse {
+		if candle[0] >= cutoffTimestamp {
+			filtered = append(filtered, candle)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,32 @@
+package coingecko_ohlc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// createTestCandles creates candles for the last days, timestamps are in the middle of steps
+func createTestCandles(days int, step time.Duration) OHLCResponse {
+	now := time.Now()
+	var candles OHLCResponse
+	for t := now.AddDate(0, 0, -days).Add(step / 2); t.Before(now); t = t.Add(step) {
+		candles = append(candles, OHLCData{float64(t.UnixMilli()), 100, 110, 90, 105})
+	}
+	return candles
+}
+
+func TestStripOHLCResponse(t *testing.T) {
+	candles := createTestCandles(30, 4*time.Hour)
+
+	stripped := StripOHLCResponse(OHLCParams{Days: "7"}, candles)
+	assert.Len(t, stripped, 7*6)
+	cutoff := float64(time.Now().AddDate(0, 0, -7).UnixMilli())
+	for _, candle := range stripped {
+		assert.GreaterOrEqual(t, candle[0], cutoff)
+	}
+
+	assert.Len(t, StripOHLCResponse(OHLCParams{Days: "30"}, candles), len(candles))
+	assert.Len(t, StripOHLCResponse(OHLCParams{Days: "max"}, candles), len(candles))
+}
@@ -0,0 +1,64 @@
+package coingecko_ohlc
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Intervals of candles requested explicitly, available upstream on paid plans only
+const (
+	IntervalHourly = "hourly"
+	IntervalDaily  = "daily"
+)
+
+var validDays = []string{"1", "7", "14", "30", "90", "180", "365", "max"}
+
+type OHLCParams struct {
+	ID       string `json:"id"`
+	Currency string `json:"vs_currency"`
+	// Days must be one of 1, 7, 14, 30, 90, 180, 365 or "max"
+	Days string `json:"days"`
+	// Interval specifies candle size ("hourly" or "daily")
+	// Leave empty for automatic granularity based on days:
+	// - 1-2 days = 30 minutes
+	// - 3-30 days = 4 hours
+	// - above 30 days = 4 days
+	Interval string `json:"interval,omitempty"`
+}
+
+func (p *OHLCParams) Validate() error {
+	if strings.TrimSpace(p.ID) == "" {
+		return errors.New("coin ID is required")
+	}
+
+	if p.Days != "" {
+		isValid := false
+		for _, days := range validDays {
+			if p.Days == days {
+				isValid = true
+				break
+			}
+		}
+		if !isValid {
+			return errors.New("invalid days parameter, must be one of: " + strings.Join(validDays, ", "))
+		}
+	}
+
+	if p.Interval != "" && p.Interval != IntervalHourly && p.Interval != IntervalDaily {
+		return errors.New("invalid interval parameter, must be one of: hourly, daily")
+	}
+
+	return nil
+}
+
+// daysInt returns days as integer, false for "max"
+func (p *OHLCParams) daysInt() (int, bool) {
+	days, err := strconv.Atoi(p.Days)
+	return days, err == nil
+}
+
+// OHLCData is a candle: [timestamp ms, open, high, low, close]
+type OHLCData [5]float64
+
+type OHLCResponse []OHLCData
@@ -0,0 +1,60 @@
+package coingecko_prices
+
+import (
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// conversionBaseCurrency is the cached currency used to derive prices in other currencies
+const conversionBaseCurrency = "usd"
+
+// derivedFieldSuffixes are suffixes of currency fields which scale with exchange rate.
+// 24h change is not derived as it depends on the exchange rate movement as well.
+var derivedFieldSuffixes = []string{"", "_market_cap", "_24h_vol"}
+
+// SetCurrencyConverter enables derivation of prices in currencies which are not cached
+func (s *Service) SetCurrencyConverter(converter interfaces.ICurrencyConverter) {
+	s.currencyConverter = converter
+}
+
+// addDerivedCurrencies adds values in requested currencies which are not cached, converted
+// from USD values using exchange rates. Returns the list of derived currencies.
+func (s *Service) addDerivedCurrencies(response interfaces.SimplePriceResponse, currencies []string) []string {
+	if s.currencyConverter == nil {
+		return nil
+	}
+
+	cachedCurrencies := make(map[string]bool)
+	for _, currency := range s.getConfigCurrencies() {
+		cachedCurrencies[currency] = true
+	}
+	if !cachedCurrencies[conversionBaseCurrency] {
+		return nil
+	}
+
+	var derived []string
+	for _, currency := range currencies {
+		if cachedCurrencies[currency] {
+			continue
+		}
+
+		rate, ok := s.currencyConverter.ConversionRate(conversionBaseCurrency, currency)
+		if !ok {
+			continue
+		}
+		derived = append(derived, currency)
+
+		for _, tokenData := range response {
+			tokenMap, ok := tokenData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, suffix := range derivedFieldSuffixes {
+				if value, ok := tokenMap[conversionBaseCurrency+suffix].(float64); ok {
+					tokenMap[currency+suffix] = value * rate
+				}
+			}
+		}
+	}
+
+	return derived
+}
@@ -0,0 +1,53 @@
+package coingecko_prices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cg "github.com/status-im/market-proxy/interfaces"
+)
+
+type staticConverter map[string]float64
+
+func (c staticConverter) ConversionRate(from, to string) (float64, bool) {
+	rate, ok := c[from+":"+to]
+	return rate, ok
+}
+
+func TestService_AddDerivedCurrencies(t *testing.T) {
+	service := &Service{config: createTestConfig()}
+	service.SetCurrencyConverter(staticConverter{"usd:chf": 0.8})
+
+	response := cg.SimplePriceResponse{
+		"bitcoin": map[string]interface{}{
+			"usd":            100.0,
+			"usd_market_cap": 1000.0,
+			"usd_24h_vol":    10.0,
+			"usd_24h_change": 5.0,
+			"eur":            90.0,
+		},
+	}
+
+	derived := service.addDerivedCurrencies(response, []string{"eur", "chf", "jpy"})
+	assert.Equal(t, []string{"chf"}, derived)
+
+	bitcoin := response["bitcoin"].(map[string]interface{})
+	assert.InDelta(t, 80.0, bitcoin["chf"], 1e-9)
+	assert.InDelta(t, 800.0, bitcoin["chf_market_cap"], 1e-9)
+	assert.InDelta(t, 8.0, bitcoin["chf_24h_vol"], 1e-9)
+	assert.NotContains(t, bitcoin, "chf_24h_change")
+	assert.NotContains(t, bitcoin, "jpy")
+
+	// Derived values pass response filtering
+	stripped := stripResponse(response, cg.PriceParams{Currencies: []string{"chf"}, IncludeMarketCap: true})
+	assert.Equal(t, map[string]interface{}{"chf": 80.0, "chf_market_cap": 800.0}, stripped["bitcoin"])
+}
+
+func TestService_AddDerivedCurrencies_NoConverter(t *testing.T) {
+	service := &Service{config: createTestConfig()}
+
+	response := cg.SimplePriceResponse{"bitcoin": map[string]interface{}{"usd": 100.0}}
+	assert.Nil(t, service.addDerivedCurrencies(response, []string{"chf"}))
+	assert.NotContains(t, response["bitcoin"], "chf")
+}
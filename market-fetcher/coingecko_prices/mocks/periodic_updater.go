@@ -12,6 +12,7 @@ package mock_coingecko_prices
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	config "github.com/status-im/market-proxy/config"
 	gomock "go.uber.org/mock/gomock"
@@ -21,6 +22,7 @@ import (
 type MockIPeriodicUpdater struct {
 	ctrl     *gomock.Controller
 	recorder *MockIPeriodicUpdaterMockRecorder
+	isgomock struct{}
 }
 
 // MockIPeriodicUpdaterMockRecorder is the mock recorder for MockIPeriodicUpdater.
@@ -41,15 +43,15 @@ func (m *MockIPeriodicUpdater) EXPECT() *MockIPeriodicUpdaterMockRecorder {
 }
 
 // ForceUpdate mocks base method.
-func (m *MockIPeriodicUpdater) ForceUpdate(arg0 context.Context) {
+func (m *MockIPeriodicUpdater) ForceUpdate(ctx context.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ForceUpdate", arg0)
+	m.ctrl.Call(m, "ForceUpdate", ctx)
 }
 
 // ForceUpdate indicates an expected call of ForceUpdate.
-func (mr *MockIPeriodicUpdaterMockRecorder) ForceUpdate(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) ForceUpdate(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUpdate", reflect.TypeOf((*MockIPeriodicUpdater)(nil).ForceUpdate), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUpdate", reflect.TypeOf((*MockIPeriodicUpdater)(nil).ForceUpdate), ctx)
 }
 
 // GetCacheData mocks base method.
@@ -67,17 +69,31 @@ func (mr *MockIPeriodicUpdaterMockRecorder) GetCacheData() *gomock.Call {
 }
 
 // GetCacheDataForTier mocks base method.
-func (m *MockIPeriodicUpdater) GetCacheDataForTier(arg0 string) map[string][]byte {
+func (m *MockIPeriodicUpdater) GetCacheDataForTier(tierName string) map[string][]byte {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCacheDataForTier", arg0)
+	ret := m.ctrl.Call(m, "GetCacheDataForTier", tierName)
 	ret0, _ := ret[0].(map[string][]byte)
 	return ret0
 }
 
 // GetCacheDataForTier indicates an expected call of GetCacheDataForTier.
-func (mr *MockIPeriodicUpdaterMockRecorder) GetCacheDataForTier(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) GetCacheDataForTier(tierName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCacheDataForTier", reflect.TypeOf((*MockIPeriodicUpdater)(nil).GetCacheDataForTier), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCacheDataForTier", reflect.TypeOf((*MockIPeriodicUpdater)(nil).GetCacheDataForTier), tierName)
+}
+
+// HasStaleRestoredData mocks base method.
+func (m *MockIPeriodicUpdater) HasStaleRestoredData() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasStaleRestoredData")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasStaleRestoredData indicates an expected call of HasStaleRestoredData.
+func (mr *MockIPeriodicUpdaterMockRecorder) HasStaleRestoredData() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasStaleRestoredData", reflect.TypeOf((*MockIPeriodicUpdater)(nil).HasStaleRestoredData))
 }
 
 // Healthy mocks base method.
@@ -94,66 +110,90 @@ func (mr *MockIPeriodicUpdaterMockRecorder) Healthy() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthy", reflect.TypeOf((*MockIPeriodicUpdater)(nil).Healthy))
 }
 
+// RefreshIds mocks base method.
+func (m *MockIPeriodicUpdater) RefreshIds(ctx context.Context, ids []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RefreshIds", ctx, ids)
+}
+
+// RefreshIds indicates an expected call of RefreshIds.
+func (mr *MockIPeriodicUpdaterMockRecorder) RefreshIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshIds", reflect.TypeOf((*MockIPeriodicUpdater)(nil).RefreshIds), ctx, ids)
+}
+
 // SetExtraIds mocks base method.
-func (m *MockIPeriodicUpdater) SetExtraIds(arg0 []string) {
+func (m *MockIPeriodicUpdater) SetExtraIds(ids []string) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetExtraIds", arg0)
+	m.ctrl.Call(m, "SetExtraIds", ids)
 }
 
 // SetExtraIds indicates an expected call of SetExtraIds.
-func (mr *MockIPeriodicUpdaterMockRecorder) SetExtraIds(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) SetExtraIds(ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExtraIds", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetExtraIds), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExtraIds", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetExtraIds), ids)
 }
 
 // SetOnMissingExtraIdsUpdatedCallback mocks base method.
-func (m *MockIPeriodicUpdater) SetOnMissingExtraIdsUpdatedCallback(arg0 func(context.Context, map[string][]byte)) {
+func (m *MockIPeriodicUpdater) SetOnMissingExtraIdsUpdatedCallback(callback func(context.Context, map[string][]byte)) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetOnMissingExtraIdsUpdatedCallback", arg0)
+	m.ctrl.Call(m, "SetOnMissingExtraIdsUpdatedCallback", callback)
 }
 
 // SetOnMissingExtraIdsUpdatedCallback indicates an expected call of SetOnMissingExtraIdsUpdatedCallback.
-func (mr *MockIPeriodicUpdaterMockRecorder) SetOnMissingExtraIdsUpdatedCallback(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) SetOnMissingExtraIdsUpdatedCallback(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnMissingExtraIdsUpdatedCallback", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetOnMissingExtraIdsUpdatedCallback), callback)
+}
+
+// SetOnTierRestoredCallback mocks base method.
+func (m *MockIPeriodicUpdater) SetOnTierRestoredCallback(callback func(context.Context, config.PriceTier, map[string][]byte, time.Time)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetOnTierRestoredCallback", callback)
+}
+
+// SetOnTierRestoredCallback indicates an expected call of SetOnTierRestoredCallback.
+func (mr *MockIPeriodicUpdaterMockRecorder) SetOnTierRestoredCallback(callback any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnMissingExtraIdsUpdatedCallback", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetOnMissingExtraIdsUpdatedCallback), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnTierRestoredCallback", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetOnTierRestoredCallback), callback)
 }
 
 // SetOnTopPricesUpdatedCallback mocks base method.
-func (m *MockIPeriodicUpdater) SetOnTopPricesUpdatedCallback(arg0 func(context.Context, config.PriceTier, map[string][]byte)) {
+func (m *MockIPeriodicUpdater) SetOnTopPricesUpdatedCallback(callback func(context.Context, config.PriceTier, map[string][]byte)) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetOnTopPricesUpdatedCallback", arg0)
+	m.ctrl.Call(m, "SetOnTopPricesUpdatedCallback", callback)
 }
 
 // SetOnTopPricesUpdatedCallback indicates an expected call of SetOnTopPricesUpdatedCallback.
-func (mr *MockIPeriodicUpdaterMockRecorder) SetOnTopPricesUpdatedCallback(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) SetOnTopPricesUpdatedCallback(callback any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnTopPricesUpdatedCallback", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetOnTopPricesUpdatedCallback), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnTopPricesUpdatedCallback", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetOnTopPricesUpdatedCallback), callback)
 }
 
 // SetTopMarketIds mocks base method.
-func (m *MockIPeriodicUpdater) SetTopMarketIds(arg0 []string) {
+func (m *MockIPeriodicUpdater) SetTopMarketIds(ids []string) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetTopMarketIds", arg0)
+	m.ctrl.Call(m, "SetTopMarketIds", ids)
 }
 
 // SetTopMarketIds indicates an expected call of SetTopMarketIds.
-func (mr *MockIPeriodicUpdaterMockRecorder) SetTopMarketIds(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) SetTopMarketIds(ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTopMarketIds", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetTopMarketIds), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTopMarketIds", reflect.TypeOf((*MockIPeriodicUpdater)(nil).SetTopMarketIds), ids)
 }
 
 // Start mocks base method.
-func (m *MockIPeriodicUpdater) Start(arg0 context.Context) error {
+func (m *MockIPeriodicUpdater) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start", arg0)
+	ret := m.ctrl.Call(m, "Start", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Start indicates an expected call of Start.
-func (mr *MockIPeriodicUpdaterMockRecorder) Start(arg0 any) *gomock.Call {
+func (mr *MockIPeriodicUpdaterMockRecorder) Start(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockIPeriodicUpdater)(nil).Start), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockIPeriodicUpdater)(nil).Start), ctx)
 }
 
 // Stop mocks base method.
@@ -0,0 +1,145 @@
+package coingecko_prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// errOnDemandBudgetExceeded is returned when on-demand fetch has no upstream requests left
+var errOnDemandBudgetExceeded = errors.New("on-demand prices budget exceeded")
+
+// onDemandLoadTimeout limits a shared on-demand fetch, which is not bound to any single request
+const onDemandLoadTimeout = 30 * time.Second
+
+// OnDemandFetcher fetches prices missing in cache at request time
+type OnDemandFetcher struct {
+	config     *config.OnDemandPricesConfig
+	currencies []string
+	cache      cache.ICache
+	fetcher    *ChunksFetcher
+	limiter    *rate.Limiter
+}
+
+// NewOnDemandFetcher creates a new on-demand fetcher with its own upstream requests budget
+func NewOnDemandFetcher(cfg *config.PricesFetcherConfig, cache cache.ICache, fetcher *ChunksFetcher) *OnDemandFetcher {
+	rpm := cfg.OnDemand.GetRequestsPerMinute()
+	burst := rpm / 10
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &OnDemandFetcher{
+		config:     &cfg.OnDemand,
+		currencies: cfg.Currencies,
+		cache:      cache,
+		fetcher:    fetcher,
+		limiter:    rate.NewLimiter(rate.Limit(float64(rpm)/60.0), burst),
+	}
+}
+
+// FetchMissing fetches prices for IDs missing in cache and caches them with a short TTL.
+// At most MaxIdsPerRequest IDs are fetched, the rest are left missing.
+// Returns a map where key is token ID and value is raw JSON data for that token.
+func (f *OnDemandFetcher) FetchMissing(ctx context.Context, ids []string) (map[string][]byte, error) {
+	if len(ids) == 0 {
+		return make(map[string][]byte), nil
+	}
+
+	maxIds := f.config.GetMaxIdsPerRequest()
+	if len(ids) > maxIds {
+		log.Printf("On-demand prices: fetching %d of %d missing tokens", maxIds, len(ids))
+		ids = ids[:maxIds]
+	}
+
+	cacheKeys := make([]string, len(ids))
+	for i, tokenID := range ids {
+		cacheKeys[i] = createTokenIDCacheKey(tokenID)
+	}
+
+	// The load is shared by all requests waiting for these keys, so cancellation of the
+	// request which started it must not fail the others
+	loader := func(missingKeys []string) (map[string][]byte, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), onDemandLoadTimeout)
+		defer cancel()
+		return f.load(loadCtx, missingKeys)
+	}
+
+	// Concurrent requests missing the same IDs share a single upstream fetch
+	loadedData, err := f.cache.GetOrLoad(cacheKeys, loader, true, f.config.GetTTL())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]byte, len(loadedData))
+	for i, tokenID := range ids {
+		if data, ok := loadedData[cacheKeys[i]]; ok {
+			result[tokenID] = data
+		}
+	}
+
+	return result, nil
+}
+
+// load fetches prices for cache keys within the on-demand budget
+func (f *OnDemandFetcher) load(ctx context.Context, cacheKeys []string) (map[string][]byte, error) {
+	ids := make([]string, len(cacheKeys))
+	for i, cacheKey := range cacheKeys {
+		ids[i] = strings.TrimPrefix(cacheKey, tokenIDCacheKeyPrefix)
+	}
+
+	// Each chunk is one upstream request, chunks above the remaining budget are left missing
+	numChunks := (len(ids) + f.fetcher.chunkSize - 1) / f.fetcher.chunkSize
+	allowedChunks := 0
+	now := time.Now()
+	for allowedChunks < numChunks && f.limiter.AllowN(now, 1) {
+		allowedChunks++
+	}
+	if allowedChunks == 0 {
+		return nil, errOnDemandBudgetExceeded
+	}
+	if allowedChunks < numChunks {
+		log.Printf("On-demand prices: budget allows %d of %d chunks", allowedChunks, numChunks)
+		ids = ids[:allowedChunks*f.fetcher.chunkSize]
+	}
+
+	log.Printf("On-demand prices: fetching %d missing tokens", len(ids))
+
+	fetchParams := interfaces.PriceParams{
+		IDs:                  ids,
+		Currencies:           f.getCurrencies(),
+		IncludeMarketCap:     true,
+		Include24hrVol:       true,
+		Include24hrChange:    true,
+		IncludeLastUpdatedAt: true,
+	}
+
+	pricesData, err := f.fetcher.FetchPrices(ctx, fetchParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch missing prices: %w", err)
+	}
+
+	result := make(map[string][]byte, len(pricesData))
+	for tokenID, data := range pricesData {
+		result[createTokenIDCacheKey(tokenID)] = data
+	}
+
+	return result, nil
+}
+
+// getCurrencies returns the configured currencies, with fallback to default
+func (f *OnDemandFetcher) getCurrencies() []string {
+	if len(f.currencies) > 0 {
+		return f.currencies
+	}
+	return []string{"usd", "eur", "btc", "eth"}
+}
@@ -0,0 +1,146 @@
+package coingecko_prices
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/config"
+	cg "github.com/status-im/market-proxy/interfaces"
+)
+
+func createOnDemandFetcher(apiClient APIClient, onDemand config.OnDemandPricesConfig) (*OnDemandFetcher, *cache.Service) {
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	cfg := &config.PricesFetcherConfig{
+		Currencies: []string{"usd"},
+		OnDemand:   onDemand,
+	}
+	return NewOnDemandFetcher(cfg, cacheService, NewChunksFetcher(apiClient, 100, 0)), cacheService
+}
+
+func TestOnDemandFetcher_FetchMissing(t *testing.T) {
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchPrices", cg.PriceParams{
+		IDs:                  []string{"token1", "token2"},
+		Currencies:           []string{"usd"},
+		IncludeMarketCap:     true,
+		Include24hrVol:       true,
+		Include24hrChange:    true,
+		IncludeLastUpdatedAt: true,
+	}).Return(map[string][]byte{
+		"token1": []byte(`{"usd": 1.0}`),
+	}, nil).Once()
+
+	fetcher, cacheService := createOnDemandFetcher(mockClient, config.OnDemandPricesConfig{Enabled: true})
+
+	result, err := fetcher.FetchMissing(context.Background(), []string{"token1", "token2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"token1": []byte(`{"usd": 1.0}`)}, result)
+
+	// Fetched prices are cached by token ID
+	cached, missing, err := cacheService.Get([]string{createTokenIDCacheKey("token1")})
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.Equal(t, []byte(`{"usd": 1.0}`), cached[createTokenIDCacheKey("token1")])
+
+	// Cached prices are not fetched again
+	result, err = fetcher.FetchMissing(context.Background(), []string{"token1"})
+	require.NoError(t, err)
+	assert.Contains(t, result, "token1")
+	mockClient.AssertNumberOfCalls(t, "FetchPrices", 1)
+}
+
+func TestOnDemandFetcher_FetchMissing_RespectsPerRequestCap(t *testing.T) {
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchPrices", cg.PriceParams{
+		IDs:                  []string{"token1", "token2"},
+		Currencies:           []string{"usd"},
+		IncludeMarketCap:     true,
+		Include24hrVol:       true,
+		Include24hrChange:    true,
+		IncludeLastUpdatedAt: true,
+	}).Return(map[string][]byte{
+		"token1": []byte(`{"usd": 1.0}`),
+		"token2": []byte(`{"usd": 2.0}`),
+	}, nil).Once()
+
+	fetcher, _ := createOnDemandFetcher(mockClient, config.OnDemandPricesConfig{Enabled: true, MaxIdsPerRequest: 2})
+
+	result, err := fetcher.FetchMissing(context.Background(), []string{"token1", "token2", "token3"})
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.NotContains(t, result, "token3")
+	mockClient.AssertExpectations(t)
+}
+
+func TestOnDemandFetcher_FetchMissing_BudgetExceeded(t *testing.T) {
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchPrices", cg.PriceParams{
+		IDs:                  []string{"token1"},
+		Currencies:           []string{"usd"},
+		IncludeMarketCap:     true,
+		Include24hrVol:       true,
+		Include24hrChange:    true,
+		IncludeLastUpdatedAt: true,
+	}).Return(map[string][]byte{}, nil).Once()
+
+	fetcher, _ := createOnDemandFetcher(mockClient, config.OnDemandPricesConfig{Enabled: true, RequestsPerMinute: 1})
+
+	// Nothing is returned, so the first request does not cache anything
+	_, err := fetcher.FetchMissing(context.Background(), []string{"token1"})
+	require.NoError(t, err)
+
+	_, err = fetcher.FetchMissing(context.Background(), []string{"token1"})
+	assert.ErrorIs(t, err, errOnDemandBudgetExceeded)
+	mockClient.AssertNumberOfCalls(t, "FetchPrices", 1)
+}
+
+func TestOnDemandFetcher_FetchMissing_ChunksAboveBurst(t *testing.T) {
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchPrices", cg.PriceParams{
+		IDs:                  []string{"token1", "token2"},
+		Currencies:           []string{"usd"},
+		IncludeMarketCap:     true,
+		Include24hrVol:       true,
+		Include24hrChange:    true,
+		IncludeLastUpdatedAt: true,
+	}).Return(map[string][]byte{
+		"token1": []byte(`{"usd": 1.0}`),
+		"token2": []byte(`{"usd": 2.0}`),
+	}, nil).Once()
+
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	cfg := &config.PricesFetcherConfig{
+		Currencies: []string{"usd"},
+		OnDemand:   config.OnDemandPricesConfig{Enabled: true, RequestsPerMinute: 10, MaxIdsPerRequest: 5},
+	}
+	// Burst is a single request, so only the first chunk of two IDs is fetched
+	fetcher := NewOnDemandFetcher(cfg, cacheService, NewChunksFetcher(mockClient, 2, 0))
+
+	result, err := fetcher.FetchMissing(context.Background(), []string{"token1", "token2", "token3", "token4", "token5"})
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Contains(t, result, "token1")
+	assert.Contains(t, result, "token2")
+	mockClient.AssertNumberOfCalls(t, "FetchPrices", 1)
+}
+
+func TestOnDemandFetcher_FetchMissing_CancelledRequest(t *testing.T) {
+	mockClient := new(MockIAPIClient)
+	mockClient.On("FetchPrices", mock.Anything).Return(map[string][]byte{
+		"token1": []byte(`{"usd": 1.0}`),
+	}, nil).Once()
+
+	fetcher, _ := createOnDemandFetcher(mockClient, config.OnDemandPricesConfig{Enabled: true})
+
+	// Shared load is detached from the request which started it
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := fetcher.FetchMissing(ctx, []string{"token1"})
+	require.NoError(t, err)
+	assert.Contains(t, result, "token1")
+}
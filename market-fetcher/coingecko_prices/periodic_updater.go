@@ -2,6 +2,7 @@ package coingecko_prices
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"sync"
@@ -11,8 +12,11 @@ import (
 	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
 	"github.com/status-im/market-proxy/scheduler"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
+const snapshotName = "prices"
+
 //go:generate mockgen -destination=mocks/periodic_updater.go . IPeriodicUpdater
 
 // IPeriodicUpdater defines the interface for periodic price updater
@@ -23,9 +27,12 @@ type IPeriodicUpdater interface {
 	SetExtraIds(ids []string)
 	SetOnTopPricesUpdatedCallback(callback func(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte))
 	SetOnMissingExtraIdsUpdatedCallback(callback func(ctx context.Context, pricesData map[string][]byte))
+	SetOnTierRestoredCallback(callback func(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte, updatedAt time.Time))
 	GetCacheData() map[string][]byte
 	GetCacheDataForTier(tierName string) map[string][]byte
 	ForceUpdate(ctx context.Context)
+	RefreshIds(ctx context.Context, ids []string)
+	HasStaleRestoredData() bool
 	Healthy() bool
 }
 
@@ -34,16 +41,27 @@ type TierDataWithTimestamp struct {
 	Data            map[string][]byte
 	Timestamp       time.Time  // Last successful update time
 	UpdateStartTime *time.Time // Time when current update started (nil if not updating)
+	Restored        bool       // Data was restored from snapshot and not refreshed yet
+}
+
+// tierSnapshot is a persisted form of TierDataWithTimestamp
+type tierSnapshot struct {
+	Data      map[string]json.RawMessage `json:"data"`
+	Timestamp time.Time                  `json:"timestamp"`
 }
 
 // PeriodicUpdater handles periodic updates of prices data
 type PeriodicUpdater struct {
 	config                   *config.PricesFetcherConfig
+	configMu                 sync.RWMutex         // protects config replaced on config reload
 	scheduler                *scheduler.Scheduler // Single scheduler for all tiers
 	apiClient                APIClient
 	metricsWriter            *metrics.MetricsWriter
+	snapshotSaver            *snapshot.Saver
+	snapshotStore            *snapshot.Store
 	onTopPricesUpdated       func(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte)
 	onMissingExtraIdsUpdated func(ctx context.Context, pricesData map[string][]byte)
+	onTierRestored           func(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte, updatedAt time.Time)
 
 	// Cache for prices data per tier with timestamps
 	cache struct {
@@ -62,6 +80,15 @@ type PeriodicUpdater struct {
 		sync.RWMutex
 		ids []string
 	}
+
+	// IDs queued or being refreshed on demand, refresh requests are deduplicated
+	// and coalesced into batches fetched by a single worker
+	refreshing struct {
+		sync.Mutex
+		ids     map[string]struct{}
+		queue   []string
+		running bool
+	}
 }
 
 // NewPeriodicUpdater creates a new periodic prices updater
@@ -74,20 +101,60 @@ func NewPeriodicUpdater(cfg *config.PricesFetcherConfig, apiClient APIClient) *P
 
 	// Initialize tier cache
 	updater.cache.tiers = make(map[string]*TierDataWithTimestamp)
+	updater.refreshing.ids = make(map[string]struct{})
 
 	return updater
 }
 
+// getConfig returns the current fetcher config
+func (u *PeriodicUpdater) getConfig() *config.PricesFetcherConfig {
+	u.configMu.RLock()
+	defer u.configMu.RUnlock()
+	return u.config
+}
+
+// SetConfig replaces the fetcher config, new tiers and intervals are picked up by the next tiers check.
+// Data of tiers which are not configured anymore is dropped.
+func (u *PeriodicUpdater) SetConfig(cfg *config.PricesFetcherConfig) {
+	u.configMu.Lock()
+	u.config = cfg
+	u.configMu.Unlock()
+
+	configured := make(map[string]bool, len(cfg.Tiers))
+	for _, tier := range cfg.Tiers {
+		configured[tier.Name] = true
+	}
+
+	u.cache.Lock()
+	defer u.cache.Unlock()
+	for name := range u.cache.tiers {
+		if !configured[name] {
+			log.Printf("Prices tier '%s' removed from config, dropping its data", name)
+			delete(u.cache.tiers, name)
+		}
+	}
+}
+
 // SetOnTopPricesUpdatedCallback sets a callback function that will be called when tier data is updated
 func (u *PeriodicUpdater) SetOnTopPricesUpdatedCallback(callback func(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte)) {
 	u.onTopPricesUpdated = callback
 }
 
+// SetOnTierRestoredCallback sets a callback function that will be called when tier data is restored from snapshot
+func (u *PeriodicUpdater) SetOnTierRestoredCallback(callback func(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte, updatedAt time.Time)) {
+	u.onTierRestored = callback
+}
+
 // SetOnMissingExtraIdsUpdatedCallback sets a callback function that will be called when missing extra IDs are updated
 func (u *PeriodicUpdater) SetOnMissingExtraIdsUpdatedCallback(callback func(ctx context.Context, pricesData map[string][]byte)) {
 	u.onMissingExtraIdsUpdated = callback
 }
 
+// SetSnapshotStore enables warm-start snapshots of tier data
+func (u *PeriodicUpdater) SetSnapshotStore(store *snapshot.Store) {
+	u.snapshotStore = store
+}
+
 // SetTopMarketIds sets the list of top market token IDs to fetch for tiers
 func (u *PeriodicUpdater) SetTopMarketIds(ids []string) {
 	u.topMarketIds.Lock()
@@ -143,16 +210,106 @@ func (u *PeriodicUpdater) GetCacheDataForTierWithTimestamp(tierName string) *Tie
 
 // Start starts the periodic updater
 func (u *PeriodicUpdater) Start(ctx context.Context) error {
-	if err := u.config.Validate(); err != nil {
+	if err := u.getConfig().Validate(); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	u.restoreSnapshot(ctx)
+	u.snapshotSaver = snapshot.NewSaver(u.snapshotStore, snapshotName, u.snapshot)
+	u.snapshotSaver.Start(ctx)
+
 	return u.startAllTiers(ctx)
 }
 
+// restoreSnapshot loads tier data saved by a previous run and passes it to the callback.
+// Tiers older than the hard TTL are not restored.
+func (u *PeriodicUpdater) restoreSnapshot(ctx context.Context) {
+	var tiers map[string]tierSnapshot
+	found, err := u.snapshotStore.Load(snapshotName, &tiers)
+	if err != nil {
+		log.Printf("Failed to restore prices snapshot: %v", err)
+		return
+	}
+	if !found {
+		return
+	}
+
+	cfg := u.getConfig()
+	for _, tier := range cfg.Tiers {
+		saved, exists := tiers[tier.Name]
+		if !exists || len(saved.Data) == 0 {
+			continue
+		}
+		if time.Since(saved.Timestamp) >= cfg.GetHardTTL() {
+			log.Printf("Skipping prices tier '%s' snapshot, it is older than hard TTL (updated at %v)",
+				tier.Name, saved.Timestamp.Format(time.RFC3339))
+			continue
+		}
+
+		data := make(map[string][]byte, len(saved.Data))
+		for id, raw := range saved.Data {
+			data[id] = raw
+		}
+
+		u.cache.Lock()
+		u.cache.tiers[tier.Name] = &TierDataWithTimestamp{
+			Data:      data,
+			Timestamp: saved.Timestamp,
+			Restored:  true,
+		}
+		u.cache.Unlock()
+
+		log.Printf("Restored prices tier '%s' from snapshot with %d tokens (updated at %v)",
+			tier.Name, len(data), saved.Timestamp.Format(time.RFC3339))
+
+		if u.onTierRestored != nil {
+			u.onTierRestored(ctx, tier, data, saved.Timestamp)
+		}
+	}
+}
+
+// snapshot returns tier data to persist
+func (u *PeriodicUpdater) snapshot() (interface{}, error) {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	tiers := make(map[string]tierSnapshot, len(u.cache.tiers))
+	for name, tierData := range u.cache.tiers {
+		if tierData == nil || len(tierData.Data) == 0 {
+			continue
+		}
+		data := make(map[string]json.RawMessage, len(tierData.Data))
+		for id, raw := range tierData.Data {
+			data[id] = raw
+		}
+		tiers[name] = tierSnapshot{Data: data, Timestamp: tierData.Timestamp}
+	}
+
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+	return tiers, nil
+}
+
+// HasStaleRestoredData returns true if data restored from snapshot is older
+// than its tier update interval and hasn't been refreshed yet
+func (u *PeriodicUpdater) HasStaleRestoredData() bool {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	now := time.Now()
+	for _, tier := range u.getConfig().Tiers {
+		tierData := u.cache.tiers[tier.Name]
+		if tierData != nil && tierData.Restored && now.Sub(tierData.Timestamp) >= tier.UpdateInterval {
+			return true
+		}
+	}
+	return false
+}
+
 // startAllTiers starts a single scheduler that manages all tiers
 func (u *PeriodicUpdater) startAllTiers(ctx context.Context) error {
-	log.Printf("Starting prices periodic updater with single scheduler for %d tiers", len(u.config.Tiers))
+	log.Printf("Starting prices periodic updater with single scheduler for %d tiers", len(u.getConfig().Tiers))
 
 	// Create single scheduler that runs every 2 seconds
 	u.scheduler = scheduler.New(
@@ -173,14 +330,67 @@ func (u *PeriodicUpdater) Stop() {
 	if u.scheduler != nil {
 		u.scheduler.Stop()
 	}
+	if u.snapshotSaver != nil {
+		u.snapshotSaver.Stop()
+	}
 }
 
 // ForceUpdate triggers immediate execution of all tiers
 func (u *PeriodicUpdater) ForceUpdate(ctx context.Context) {
-	log.Printf("Force updating all %d tiers", len(u.config.Tiers))
+	log.Printf("Force updating all %d tiers", len(u.getConfig().Tiers))
 	u.checkAndUpdateTiers(ctx, true) // force = true
 }
 
+// TierStatuses returns update status of configured tiers
+func (u *PeriodicUpdater) TierStatuses() []interfaces.TierStatus {
+	u.cache.RLock()
+	defer u.cache.RUnlock()
+
+	tiers := u.getConfig().Tiers
+	statuses := make([]interfaces.TierStatus, 0, len(tiers))
+	for _, tier := range tiers {
+		status := interfaces.TierStatus{Name: tier.Name}
+		if tierData := u.cache.tiers[tier.Name]; tierData != nil {
+			status.LastUpdate = tierData.Timestamp
+			status.ItemCount = len(tierData.Data)
+			status.UpdatingSince = tierData.UpdateStartTime
+			status.Restored = tierData.Restored
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// RefreshTier starts an update of the named tier in background
+func (u *PeriodicUpdater) RefreshTier(ctx context.Context, name string) error {
+	var tier *config.PriceTier
+	for _, t := range u.getConfig().Tiers {
+		if t.Name == name {
+			tier = &t
+			break
+		}
+	}
+	if tier == nil {
+		return fmt.Errorf("%w: %s", interfaces.ErrUnknownTier, name)
+	}
+
+	u.cache.RLock()
+	tierData := u.cache.tiers[name]
+	isUpdating := tierData != nil && tierData.UpdateStartTime != nil
+	u.cache.RUnlock()
+	if isUpdating {
+		return fmt.Errorf("%w: %s", interfaces.ErrTierUpdating, name)
+	}
+
+	log.Printf("Force updating tier '%s'", name)
+	go func(t config.PriceTier) {
+		if err := u.fetchAndUpdateTier(ctx, t); err != nil {
+			log.Printf("Error updating tier '%s' data: %v", t.Name, err)
+		}
+	}(*tier)
+	return nil
+}
+
 // checkAndUpdateTiers checks all tiers and starts updates if needed
 func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force ...bool) {
 	forceUpdate := len(force) > 0 && force[0]
@@ -198,7 +408,7 @@ func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force ...bool
 
 	now := time.Now()
 
-	for _, tier := range u.config.Tiers {
+	for _, tier := range u.getConfig().Tiers {
 		shouldUpdate := false
 		var lastUpdate time.Time
 		var isUpdating bool
@@ -314,18 +524,7 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Pr
 	}
 
 	// Fetch prices using chunks fetcher
-	requestDelay := u.config.RequestDelay
-	requestDelayMs := int(requestDelay.Milliseconds())
-	if requestDelayMs < 0 {
-		requestDelayMs = DEFAULT_REQUEST_DELAY
-	}
-
-	chunkSize := u.config.ChunkSize
-	if chunkSize <= 0 {
-		chunkSize = DEFAULT_CHUNK_SIZE
-	}
-
-	fetcher := NewChunksFetcher(u.apiClient, chunkSize, requestDelayMs)
+	fetcher := u.newChunksFetcher()
 
 	// Create onChunk callback to update cache with partial data
 	onChunkCallback := func(chunkData map[string][]byte) {
@@ -411,18 +610,7 @@ func (u *PeriodicUpdater) fetchMissingExtraIds(ctx context.Context, tier config.
 	}
 
 	// Use chunks fetcher to handle large number of missing IDs
-	requestDelay := u.config.RequestDelay
-	requestDelayMs := int(requestDelay.Milliseconds())
-	if requestDelayMs < 0 {
-		requestDelayMs = DEFAULT_REQUEST_DELAY
-	}
-
-	chunkSize := u.config.ChunkSize
-	if chunkSize <= 0 {
-		chunkSize = DEFAULT_CHUNK_SIZE
-	}
-
-	chunksFetcher := NewChunksFetcher(u.apiClient, chunkSize, requestDelayMs)
+	chunksFetcher := u.newChunksFetcher()
 
 	// Create onChunk callback to update missing extra IDs immediately
 	onChunkCallback := func(chunkData map[string][]byte) {
@@ -446,12 +634,101 @@ func (u *PeriodicUpdater) fetchMissingExtraIds(ctx context.Context, tier config.
 	return pricesData, nil
 }
 
+// RefreshIds queues the given IDs for a background refresh, fetched prices are passed to the
+// missing extra IDs callback. IDs which are already queued or being refreshed are skipped.
+// A single worker drains the queue, IDs queued while it is fetching are fetched in the next batch.
+func (u *PeriodicUpdater) RefreshIds(ctx context.Context, ids []string) {
+	u.refreshing.Lock()
+	defer u.refreshing.Unlock()
+
+	for _, id := range ids {
+		if _, exists := u.refreshing.ids[id]; exists {
+			continue
+		}
+		u.refreshing.ids[id] = struct{}{}
+		u.refreshing.queue = append(u.refreshing.queue, id)
+	}
+
+	if len(u.refreshing.queue) > 0 && !u.refreshing.running {
+		u.refreshing.running = true
+		go u.refreshQueued(ctx)
+	}
+}
+
+// refreshQueued fetches queued IDs in batches until the queue is empty
+func (u *PeriodicUpdater) refreshQueued(ctx context.Context) {
+	for {
+		u.refreshing.Lock()
+		idsToRefresh := u.refreshing.queue
+		u.refreshing.queue = nil
+		if len(idsToRefresh) == 0 {
+			u.refreshing.running = false
+			u.refreshing.Unlock()
+			return
+		}
+		u.refreshing.Unlock()
+
+		u.refreshBatch(ctx, idsToRefresh)
+		u.finishRefreshing(idsToRefresh)
+	}
+}
+
+// refreshBatch fetches prices of IDs and passes them to the missing extra IDs callback
+func (u *PeriodicUpdater) refreshBatch(ctx context.Context, ids []string) {
+	log.Printf("Refreshing %d stale price IDs", len(ids))
+
+	fetchParams := interfaces.PriceParams{
+		IDs:                  ids,
+		Currencies:           u.getConfigCurrencies(),
+		IncludeMarketCap:     true,
+		Include24hrVol:       true,
+		Include24hrChange:    true,
+		IncludeLastUpdatedAt: true,
+	}
+
+	pricesData, err := u.newChunksFetcher().FetchPrices(ctx, fetchParams, nil)
+	if err != nil {
+		log.Printf("Failed to refresh stale price IDs: %v", err)
+		return
+	}
+
+	if len(pricesData) > 0 && u.onMissingExtraIdsUpdated != nil {
+		u.onMissingExtraIdsUpdated(ctx, pricesData)
+	}
+}
+
+// finishRefreshing clears refreshing state of IDs
+func (u *PeriodicUpdater) finishRefreshing(ids []string) {
+	u.refreshing.Lock()
+	defer u.refreshing.Unlock()
+
+	for _, id := range ids {
+		delete(u.refreshing.ids, id)
+	}
+}
+
+// newChunksFetcher creates chunks fetcher according to configuration
+func (u *PeriodicUpdater) newChunksFetcher() *ChunksFetcher {
+	requestDelay := u.getConfig().RequestDelay
+	requestDelayMs := int(requestDelay.Milliseconds())
+	if requestDelayMs < 0 {
+		requestDelayMs = DEFAULT_REQUEST_DELAY
+	}
+
+	chunkSize := u.getConfig().ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DEFAULT_CHUNK_SIZE
+	}
+
+	return NewChunksFetcher(u.apiClient, chunkSize, requestDelayMs)
+}
+
 // findMissingOrStaleIds finds IDs that are missing from all tiers or have stale data
 func (u *PeriodicUpdater) findMissingOrStaleIds(extraIds []string) []string {
 	u.cache.RLock()
 	defer u.cache.RUnlock()
 
-	halfTTL := u.config.GetTTL() / 2
+	halfTTL := u.getConfig().GetTTL() / 2
 	now := time.Now()
 	missingIds := make([]string, 0)
 
@@ -487,8 +764,8 @@ func (u *PeriodicUpdater) findMissingOrStaleIds(extraIds []string) []string {
 
 // getConfigCurrencies returns the currencies from config, with fallback to default
 func (u *PeriodicUpdater) getConfigCurrencies() []string {
-	if u.config != nil && len(u.config.Currencies) > 0 {
-		return u.config.Currencies
+	if cfg := u.getConfig(); cfg != nil && len(cfg.Currencies) > 0 {
+		return cfg.Currencies
 	}
 	// Fallback to default currencies if config is not available or empty
 	return []string{"usd", "eur", "btc", "eth"}
@@ -0,0 +1,150 @@
+package coingecko_prices
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/status-im/market-proxy/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingAPIClient returns prices for requested IDs once released
+type blockingAPIClient struct {
+	release chan struct{}
+	mu      sync.Mutex
+	calls   int
+}
+
+func (c *blockingAPIClient) FetchPrices(params interfaces.PriceParams) (map[string][]byte, error) {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+
+	<-c.release
+	result := make(map[string][]byte, len(params.IDs))
+	for _, id := range params.IDs {
+		result[id] = []byte(`{"usd": 1}`)
+	}
+	return result, nil
+}
+
+func (c *blockingAPIClient) Healthy() bool {
+	return true
+}
+
+func TestPeriodicUpdater_RefreshIds(t *testing.T) {
+	client := &blockingAPIClient{release: make(chan struct{})}
+	cfg := createTestConfig().CoingeckoPrices
+	updater := NewPeriodicUpdater(&cfg, client)
+
+	refreshed := make(chan map[string][]byte, 2)
+	updater.SetOnMissingExtraIdsUpdatedCallback(func(ctx context.Context, pricesData map[string][]byte) {
+		refreshed <- pricesData
+	})
+
+	updater.RefreshIds(context.Background(), []string{"bitcoin"})
+	// Duplicate request while refresh is in flight is skipped
+	updater.RefreshIds(context.Background(), []string{"bitcoin"})
+	close(client.release)
+
+	select {
+	case data := <-refreshed:
+		assert.Contains(t, data, "bitcoin")
+	case <-time.After(time.Second):
+		require.Fail(t, "refresh callback was not called")
+	}
+
+	select {
+	case <-refreshed:
+		assert.Fail(t, "duplicate refresh should be skipped")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	client.mu.Lock()
+	assert.Equal(t, 1, client.calls)
+	client.mu.Unlock()
+}
+
+func TestPeriodicUpdater_RefreshIdsCoalesced(t *testing.T) {
+	client := &blockingAPIClient{release: make(chan struct{})}
+	cfg := createTestConfig().CoingeckoPrices
+	updater := NewPeriodicUpdater(&cfg, client)
+
+	refreshed := make(chan map[string][]byte, 3)
+	updater.SetOnMissingExtraIdsUpdatedCallback(func(ctx context.Context, pricesData map[string][]byte) {
+		refreshed <- pricesData
+	})
+
+	updater.RefreshIds(context.Background(), []string{"bitcoin"})
+	require.Eventually(t, func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return client.calls == 1
+	}, time.Second, time.Millisecond)
+
+	// Requests queued while the worker is fetching are fetched together in the next batch
+	updater.RefreshIds(context.Background(), []string{"ethereum"})
+	updater.RefreshIds(context.Background(), []string{"dai", "ethereum"})
+	close(client.release)
+
+	for _, expected := range [][]string{{"bitcoin"}, {"ethereum", "dai"}} {
+		select {
+		case data := <-refreshed:
+			assert.Len(t, data, len(expected))
+			for _, id := range expected {
+				assert.Contains(t, data, id)
+			}
+		case <-time.After(time.Second):
+			require.Fail(t, "refresh callback was not called")
+		}
+	}
+
+	client.mu.Lock()
+	assert.Equal(t, 2, client.calls)
+	client.mu.Unlock()
+}
+
+func TestPeriodicUpdater_SetConfig(t *testing.T) {
+	cfg := createTestConfig().CoingeckoPrices
+	updater := NewPeriodicUpdater(&cfg, &blockingAPIClient{release: make(chan struct{})})
+
+	updater.cache.tiers["top-1000"] = &TierDataWithTimestamp{Data: map[string][]byte{"bitcoin": []byte(`{"usd": 1}`)}}
+	updater.cache.tiers["top-1001-10000"] = &TierDataWithTimestamp{Data: map[string][]byte{"dai": []byte(`{"usd": 1}`)}}
+
+	// Second tier is removed from config
+	newCfg := createTestConfig().CoingeckoPrices
+	newCfg.Tiers = newCfg.Tiers[:1]
+	updater.SetConfig(&newCfg)
+
+	assert.Same(t, &newCfg, updater.getConfig())
+	assert.Contains(t, updater.GetCacheData(), "bitcoin")
+	assert.NotContains(t, updater.GetCacheData(), "dai")
+}
+
+func TestPeriodicUpdater_TierStatusesAndRefreshTier(t *testing.T) {
+	cfg := createTestConfig().CoingeckoPrices
+	updater := NewPeriodicUpdater(&cfg, &blockingAPIClient{release: make(chan struct{})})
+
+	updateStart := time.Now()
+	updater.cache.tiers["top-1000"] = &TierDataWithTimestamp{
+		Data:            map[string][]byte{"bitcoin": []byte(`{"usd": 1}`)},
+		Timestamp:       updateStart.Add(-time.Minute),
+		UpdateStartTime: &updateStart,
+	}
+
+	statuses := updater.TierStatuses()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "top-1000", statuses[0].Name)
+	assert.Equal(t, 1, statuses[0].ItemCount)
+	assert.Equal(t, &updateStart, statuses[0].UpdatingSince)
+	assert.True(t, statuses[1].LastUpdate.IsZero())
+
+	err := updater.RefreshTier(context.Background(), "top-1000")
+	assert.ErrorIs(t, err, interfaces.ErrTierUpdating)
+
+	err = updater.RefreshTier(context.Background(), "unknown")
+	assert.ErrorIs(t, err, interfaces.ErrUnknownTier)
+}
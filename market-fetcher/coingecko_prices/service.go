@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/status-im/market-proxy/interfaces"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/status-im/market-proxy/cache"
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 // Service provides price fetching functionality with caching
@@ -20,14 +23,20 @@ type Service struct {
 	cache                          cache.ICache
 	fetcher                        *ChunksFetcher
 	config                         *config.Config
+	configMu                       sync.RWMutex // protects config replaced on config reload
 	metricsWriter                  *metrics.MetricsWriter
 	subscriptionManager            *events.SubscriptionManager
 	periodicUpdater                IPeriodicUpdater
 	marketsService                 interfaces.IMarketsService
 	tokensService                  interfaces.ITokensService
+	onDemandFetcher                *OnDemandFetcher // nil if on-demand fetching is disabled
+	currencyConverter              interfaces.ICurrencyConverter
+	priceHistory                   interfaces.IPriceHistoryRecorder // nil if price history is disabled
 	marketUpdateSubscription       events.ISubscription
 	tokenUpdateSubscription        events.ISubscription
 	marketsInitializedSubscription events.ISubscription
+	// ctx is the service lifetime context used for background refreshes
+	ctx context.Context
 }
 
 // NewService creates a new price service with the given cache and config
@@ -58,16 +67,52 @@ func NewService(cache cache.ICache, config *config.Config, marketsService interf
 		tokensService:       tokensService,
 	}
 
+	if config.CoingeckoPrices.OnDemand.Enabled {
+		service.onDemandFetcher = NewOnDemandFetcher(&config.CoingeckoPrices, cache, fetcher)
+	}
+
 	// Create periodic updater
 	service.periodicUpdater = NewPeriodicUpdater(&config.CoingeckoPrices, apiClient)
 
 	// Set callbacks to handle data updates
 	service.periodicUpdater.SetOnTopPricesUpdatedCallback(service.handleTopPricesUpdate)
 	service.periodicUpdater.SetOnMissingExtraIdsUpdatedCallback(service.handleMissingExtraIdsUpdate)
+	service.periodicUpdater.SetOnTierRestoredCallback(service.handleTierRestored)
 
 	return service
 }
 
+// getConfig returns the current config
+func (s *Service) getConfig() *config.Config {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+	return s.config
+}
+
+// ApplyConfig implements core.IReloadable: tiers, intervals, currencies and TTLs are applied live,
+// on-demand fetching settings require restart
+func (s *Service) ApplyConfig(cfg *config.Config) {
+	s.configMu.Lock()
+	s.config = cfg
+	s.configMu.Unlock()
+
+	if updater, ok := s.periodicUpdater.(*PeriodicUpdater); ok {
+		updater.SetConfig(&cfg.CoingeckoPrices)
+	}
+}
+
+// SetSnapshotStore enables warm-start snapshots of prices tiers
+func (s *Service) SetSnapshotStore(store *snapshot.Store) {
+	if updater, ok := s.periodicUpdater.(*PeriodicUpdater); ok {
+		updater.SetSnapshotStore(store)
+	}
+}
+
+// SetPriceHistory enables recording of tier prices updates into local price history
+func (s *Service) SetPriceHistory(recorder interfaces.IPriceHistoryRecorder) {
+	s.priceHistory = recorder
+}
+
 // handleTopPricesUpdate handles top prices update by caching tokens and emitting events
 func (s *Service) handleTopPricesUpdate(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte) {
 	// ICache prices by individual token IDs
@@ -76,8 +121,36 @@ func (s *Service) handleTopPricesUpdate(ctx context.Context, tier config.PriceTi
 		log.Printf("Failed to cache prices data by id: %v", err)
 	}
 
+	if s.priceHistory != nil {
+		s.priceHistory.RecordPrices(pricesData)
+	}
+
 	log.Printf("Prices service cache update complete: %d", len(pricesData))
-	s.subscriptionManager.Emit(ctx)
+	s.publishUpdate(ctx, tier.Name, pricesData, false)
+}
+
+// handleTierRestored caches tier prices restored from snapshot with their remaining TTL
+// and emits an event marked as restored
+func (s *Service) handleTierRestored(ctx context.Context, tier config.PriceTier, pricesData map[string][]byte, updatedAt time.Time) {
+	pricesConfig := s.getConfig().CoingeckoPrices
+	age := time.Since(updatedAt)
+	hardTTL := pricesConfig.GetHardTTL() - age
+	if hardTTL <= 0 {
+		return
+	}
+	// Restored data past its soft TTL is stale right away
+	softTTL := max(pricesConfig.GetTTL()-age, time.Nanosecond)
+
+	if err := s.cachePrices(pricesData, softTTL, hardTTL); err != nil {
+		log.Printf("Failed to cache restored prices data: %v", err)
+	}
+
+	if s.priceHistory != nil {
+		s.priceHistory.RecordPrices(pricesData)
+	}
+
+	log.Printf("Prices service restored tier '%s': %d", tier.Name, len(pricesData))
+	s.publishUpdate(ctx, tier.Name, pricesData, true)
 }
 
 // handleMissingExtraIdsUpdate handles missing extra IDs update by caching tokens and emitting events
@@ -89,17 +162,32 @@ func (s *Service) handleMissingExtraIdsUpdate(ctx context.Context, pricesData ma
 	}
 
 	log.Printf("Prices service cache update complete: extra ids %d", len(pricesData))
-	s.subscriptionManager.Emit(ctx)
+	s.publishUpdate(ctx, "", pricesData, false)
+}
+
+// publishUpdate notifies subscribers about updated prices of token IDs,
+// restored marks prices restored from snapshot
+func (s *Service) publishUpdate(ctx context.Context, tierName string, pricesData map[string][]byte, restored bool) {
+	if len(pricesData) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(pricesData))
+	for tokenID := range pricesData {
+		ids = append(ids, tokenID)
+	}
+
+	s.subscriptionManager.Publish(ctx, events.Event{Tier: tierName, IDs: ids, Restored: restored})
 }
 
 // getMaxTokenLimit calculates the maximum token limit from prices tiers configuration
 func (s *Service) getMaxTokenLimit() int {
-	if s.config == nil {
+	if s.getConfig() == nil {
 		return 100000 // fallback
 	}
 
 	maxTokenTo := 0
-	for _, tier := range s.config.CoingeckoPrices.Tiers {
+	for _, tier := range s.getConfig().CoingeckoPrices.Tiers {
 		if tier.TokenTo > maxTokenTo {
 			maxTokenTo = tier.TokenTo
 		}
@@ -112,6 +200,25 @@ func (s *Service) getMaxTokenLimit() int {
 	return maxTokenTo
 }
 
+// onMarketsUpdated is called when markets data is updated
+// Updates of extra IDs only do not change the market list and are skipped
+func (s *Service) onMarketsUpdated(updates []events.Event) {
+	if len(updates) > 0 && !hasTierUpdate(updates) {
+		return
+	}
+	s.onMarketListChanged()
+}
+
+// hasTierUpdate checks if any of the events is a tier update or a full update
+func hasTierUpdate(updates []events.Event) bool {
+	for _, update := range updates {
+		if update.Tier != "" || update.IsFull() {
+			return true
+		}
+	}
+	return false
+}
+
 // onMarketListChanged is called when market list is updated
 func (s *Service) onMarketListChanged() {
 	if s.marketsService == nil {
@@ -147,6 +254,12 @@ func (s *Service) onTokenListChanged() {
 
 // cachePricesByID caches price data by individual token IDs
 func (s *Service) cachePricesByID(pricesData map[string][]byte) error {
+	pricesConfig := s.getConfig().CoingeckoPrices
+	return s.cachePrices(pricesData, pricesConfig.GetTTL(), pricesConfig.GetHardTTL())
+}
+
+// cachePrices caches price data by individual token IDs, after soft TTL it is served as stale until refreshed
+func (s *Service) cachePrices(pricesData map[string][]byte, softTTL, hardTTL time.Duration) error {
 	if len(pricesData) == 0 {
 		return nil
 	}
@@ -159,8 +272,7 @@ func (s *Service) cachePricesByID(pricesData map[string][]byte) error {
 		cacheData[cacheKey] = data
 	}
 
-	// ICache prices data
-	err := s.cache.Set(cacheData, s.config.CoingeckoPrices.GetTTL())
+	err := s.cache.SetWithSoftTTL(cacheData, softTTL, hardTTL)
 	if err != nil {
 		log.Printf("Failed to cache prices data: %v", err)
 		return fmt.Errorf("failed to cache prices data: %w", err)
@@ -169,9 +281,12 @@ func (s *Service) cachePricesByID(pricesData map[string][]byte) error {
 	return nil
 }
 
+// tokenIDCacheKeyPrefix is a prefix of cache keys of individual token IDs
+const tokenIDCacheKeyPrefix = "price:id:"
+
 // createTokenIDCacheKey creates a cache key for individual token ID
 func createTokenIDCacheKey(tokenID string) string {
-	return fmt.Sprintf("price:id:%s", tokenID)
+	return tokenIDCacheKeyPrefix + tokenID
 }
 
 // Start implements core.Interface
@@ -179,11 +294,12 @@ func (s *Service) Start(ctx context.Context) error {
 	if s.cache == nil {
 		return fmt.Errorf("cache dependency not provided")
 	}
+	s.ctx = ctx
 
 	// Subscribe to market list updates
 	if s.marketsService != nil {
 		s.marketUpdateSubscription = s.marketsService.SubscribeTopMarketsUpdate().
-			Watch(ctx, s.onMarketListChanged, true)
+			WatchEvents(ctx, s.onMarketsUpdated, true)
 
 		// Subscribe to markets initialization events
 		s.marketsInitializedSubscription = s.marketsService.SubscribeInitialized().
@@ -236,11 +352,22 @@ func (s *Service) Stop() {
 	// ICache will handle its own cleanup
 }
 
-// SimplePrices fetches prices for the given parameters using cache only
+// SimplePrices fetches prices for the given parameters from cache,
+// missing prices are fetched on demand if enabled
 // Returns raw CoinGecko JSON response with cache status
 func (s *Service) SimplePrices(ctx context.Context, params interfaces.PriceParams) (resp interfaces.SimplePriceResponse, cacheStatus interfaces.CacheStatus, err error) {
+	resp, meta, err := s.SimplePricesWithMeta(ctx, params)
+	return resp, meta.CacheStatus, err
+}
+
+// SimplePricesWithMeta fetches prices for the given parameters from cache
+// Returns raw CoinGecko JSON response with cache status and age of the served data.
+// Stale prices are served as is and refreshed in background.
+// Missing prices are fetched on demand if enabled, otherwise only cached data is returned.
+// params.CacheOnly disables on-demand fetching and refreshes of stale prices.
+func (s *Service) SimplePricesWithMeta(ctx context.Context, params interfaces.PriceParams) (interfaces.SimplePriceResponse, interfaces.ResponseMeta, error) {
 	if len(params.IDs) == 0 {
-		return interfaces.SimplePriceResponse{}, interfaces.CacheStatusFull, nil
+		return interfaces.SimplePriceResponse{}, interfaces.ResponseMeta{CacheStatus: interfaces.CacheStatusFull}, nil
 	}
 
 	// Create cache keys for individual token IDs
@@ -250,48 +377,164 @@ func (s *Service) SimplePrices(ctx context.Context, params interfaces.PriceParam
 	}
 
 	// Get data from cache only
-	cachedData, missingKeys, err := s.cache.Get(cacheKeys)
+	cachedItems, _, err := s.cache.GetItems(cacheKeys)
 	if err != nil {
 		log.Printf("Failed to check cache: %v", err)
-		return nil, interfaces.CacheStatusMiss, fmt.Errorf("failed to check cache: %w", err)
+		return nil, interfaces.ResponseMeta{CacheStatus: interfaces.CacheStatusMiss}, fmt.Errorf("failed to check cache: %w", err)
 	}
 
 	// Build response from cached data, preserving order from params.IDs
+	var meta interfaces.ResponseMeta
+	var staleIds []string
+	var missingIds []string
+	now := time.Now()
 	fullResponse := make(interfaces.SimplePriceResponse)
 	for i, tokenID := range params.IDs {
-		cacheKey := cacheKeys[i]
-		if tokenBytes, exists := cachedData[cacheKey]; exists {
-			var tokenData map[string]interface{}
-			if err := json.Unmarshal(tokenBytes, &tokenData); err == nil {
-				fullResponse[tokenID] = tokenData
-			}
+		item, exists := cachedItems[cacheKeys[i]]
+		if !exists {
+			missingIds = append(missingIds, tokenID)
+			continue
+		}
+		var tokenData map[string]interface{}
+		if err := json.Unmarshal(item.Data, &tokenData); err == nil {
+			fullResponse[tokenID] = tokenData
+		}
+		if item.Stale {
+			staleIds = append(staleIds, tokenID)
+		}
+		if age := item.Age(now); age > meta.Age {
+			meta.Age = age
 		}
 	}
 
-	// Log missing keys but don't fetch from API - only return cached data
-	if len(missingKeys) > 0 {
-		log.Printf("Missing %d tokens in cache", len(missingKeys))
+	if len(missingIds) > 0 && !params.CacheOnly {
+		missingIds = s.fetchMissingIds(ctx, missingIds, fullResponse)
+	}
+
+	// Log missing keys which are neither cached nor fetched
+	if len(missingIds) > 0 {
+		log.Printf("Missing %d tokens in cache", len(missingIds))
 	}
 
 	// Determine cache status
-	if len(missingKeys) == 0 && len(cachedData) == len(cacheKeys) {
-		cacheStatus = interfaces.CacheStatusFull
-	} else if len(cachedData) > 0 {
-		cacheStatus = interfaces.CacheStatusPartial
+	if len(missingIds) == 0 {
+		meta.CacheStatus = interfaces.CacheStatusFull
+	} else if len(missingIds) < len(params.IDs) {
+		meta.CacheStatus = interfaces.CacheStatusPartial
 	} else {
-		cacheStatus = interfaces.CacheStatusMiss
+		meta.CacheStatus = interfaces.CacheStatusMiss
+	}
+
+	// Stale prices are served while being refreshed in background,
+	// stale status doesn't hide partial or missing responses
+	if len(staleIds) > 0 {
+		if !params.CacheOnly {
+			s.refreshStaleIds(staleIds)
+		}
+		if meta.CacheStatus == interfaces.CacheStatusFull {
+			meta.CacheStatus = interfaces.CacheStatusStale
+		}
 	}
 
+	// Data restored from snapshot is served until the updater catches up
+	if meta.CacheStatus == interfaces.CacheStatusFull && s.periodicUpdater != nil && s.periodicUpdater.HasStaleRestoredData() {
+		meta.CacheStatus = interfaces.CacheStatusStale
+	}
+
+	// Derive prices in currencies which are not cached
+	meta.DerivedCurrencies = s.addDerivedCurrencies(fullResponse, params.Currencies)
+
 	// Filter the response according to user parameters
 	filteredResponse := stripResponse(fullResponse, params)
 
-	return filteredResponse, cacheStatus, nil
+	return filteredResponse, meta, nil
+}
+
+// fetchMissingIds fetches prices missing in cache on demand and adds them to response
+// Returns IDs which are still missing
+func (s *Service) fetchMissingIds(ctx context.Context, ids []string, response interfaces.SimplePriceResponse) []string {
+	if s.onDemandFetcher == nil {
+		return ids
+	}
+
+	fetchedData, err := s.onDemandFetcher.FetchMissing(ctx, ids)
+	if err != nil {
+		log.Printf("Failed to fetch missing prices on demand: %v", err)
+		return ids
+	}
+
+	stillMissing := make([]string, 0, len(ids)-len(fetchedData))
+	for _, tokenID := range ids {
+		data, ok := fetchedData[tokenID]
+		if !ok {
+			stillMissing = append(stillMissing, tokenID)
+			continue
+		}
+		var tokenData map[string]interface{}
+		if err := json.Unmarshal(data, &tokenData); err == nil {
+			response[tokenID] = tokenData
+		}
+	}
+
+	return stillMissing
+}
+
+// TierStatuses implements interfaces.ITiersAdmin
+func (s *Service) TierStatuses() []interfaces.TierStatus {
+	if updater, ok := s.periodicUpdater.(*PeriodicUpdater); ok {
+		return updater.TierStatuses()
+	}
+	return nil
+}
+
+// RefreshAll implements interfaces.ITiersAdmin
+func (s *Service) RefreshAll() error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	s.periodicUpdater.ForceUpdate(s.ctx)
+	return nil
+}
+
+// RefreshTier implements interfaces.ITiersAdmin
+func (s *Service) RefreshTier(name string) error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	updater, ok := s.periodicUpdater.(*PeriodicUpdater)
+	if !ok {
+		return fmt.Errorf("%w: %s", interfaces.ErrUnknownTier, name)
+	}
+	return updater.RefreshTier(s.ctx, name)
+}
+
+// RefreshID implements interfaces.ITiersAdmin
+func (s *Service) RefreshID(id string) error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	s.periodicUpdater.RefreshIds(s.ctx, []string{id})
+	return nil
+}
+
+// refreshStaleIds asks periodic updater to refresh stale prices in background
+func (s *Service) refreshStaleIds(ids []string) {
+	if s.periodicUpdater == nil {
+		return
+	}
+
+	// Refresh must outlive the request, so it is bound to the service lifetime
+	ctx := s.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	s.periodicUpdater.RefreshIds(ctx, ids)
 }
 
 // getConfigCurrencies returns the currencies from config, with fallback to default
 func (s *Service) getConfigCurrencies() []string {
-	if s.config != nil && len(s.config.CoingeckoPrices.Currencies) > 0 {
-		return s.config.CoingeckoPrices.Currencies
+	if cfg := s.getConfig(); cfg != nil && len(cfg.CoingeckoPrices.Currencies) > 0 {
+		return cfg.CoingeckoPrices.Currencies
 	}
 	// Fallback to default currencies if config is not available or empty
 	return []string{"usd", "eur", "btc", "eth"}
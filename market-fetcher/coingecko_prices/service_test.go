@@ -2,6 +2,7 @@ package coingecko_prices
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -10,9 +11,12 @@ import (
 
 	"github.com/status-im/market-proxy/cache"
 	cache_mocks "github.com/status-im/market-proxy/cache/mocks"
+	mock_coingecko_prices "github.com/status-im/market-proxy/coingecko_prices/mocks"
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
+	"github.com/status-im/market-proxy/snapshot"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -56,13 +60,22 @@ func createTestConfig() *config.Config {
 	}
 }
 
+// cachedItems wraps cached data into fresh cache items
+func cachedItems(data map[string][]byte) map[string]cache.Item {
+	items := make(map[string]cache.Item, len(data))
+	for key, value := range data {
+		items[key] = cache.Item{Data: value, StoredAt: time.Now()}
+	}
+	return items
+}
+
 func TestService_Basic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	// Create mock cache service - expect possible cache operations from periodic updater
 	mockCache := cache_mocks.NewMockICache(ctrl)
-	mockCache.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Create mock markets service
 	mockMarketsService := mock_interfaces.NewMockIMarketsService(ctrl)
@@ -120,9 +133,9 @@ func TestService_SimplePricesWithMissingData(t *testing.T) {
 
 	// ICache returns missing keys (not found)
 	expectedCacheKeys := []string{"price:id:bitcoin", "price:id:ethereum"}
-	mockCache.EXPECT().Get(expectedCacheKeys).Return(
-		map[string][]byte{}, // No cached data
-		expectedCacheKeys,   // All keys are missing
+	mockCache.EXPECT().GetItems(expectedCacheKeys).Return(
+		map[string]cache.Item{}, // No cached data
+		expectedCacheKeys,       // All keys are missing
 		nil,
 	)
 
@@ -131,6 +144,79 @@ func TestService_SimplePricesWithMissingData(t *testing.T) {
 	assert.NotNil(t, response)
 }
 
+func TestService_SimplePricesFetchesMissingOnDemand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCache := cache_mocks.NewMockICache(ctrl)
+
+	cfg := createTestConfig()
+	cfg.CoingeckoPrices.OnDemand = config.OnDemandPricesConfig{Enabled: true}
+
+	mockTokensService := createMockTokensService(ctrl)
+	priceService := NewService(mockCache, cfg, nil, mockTokensService)
+	require.NotNil(t, priceService.onDemandFetcher)
+
+	// Bitcoin is cached, ethereum is fetched on demand
+	mockCache.EXPECT().GetItems([]string{"price:id:bitcoin", "price:id:ethereum"}).Return(
+		cachedItems(map[string][]byte{"price:id:bitcoin": []byte(`{"usd": 50000}`)}),
+		[]string{"price:id:ethereum"},
+		nil,
+	)
+	mockCache.EXPECT().GetOrLoad([]string{"price:id:ethereum"}, gomock.Any(), true, time.Minute).Return(
+		map[string][]byte{"price:id:ethereum": []byte(`{"usd": 3000}`)},
+		nil,
+	)
+
+	response, meta, err := priceService.SimplePricesWithMeta(context.Background(), cg.PriceParams{
+		IDs:        []string{"bitcoin", "ethereum"},
+		Currencies: []string{"usd"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cg.CacheStatusFull, meta.CacheStatus)
+	assert.Contains(t, response, "bitcoin")
+	assert.Contains(t, response, "ethereum")
+}
+
+func TestService_RefreshBeforeStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	priceService := NewService(cache_mocks.NewMockICache(ctrl), createTestConfig(), nil, createMockTokensService(ctrl))
+
+	assert.ErrorIs(t, priceService.RefreshAll(), cg.ErrNotStarted)
+	assert.ErrorIs(t, priceService.RefreshTier("top"), cg.ErrNotStarted)
+	assert.ErrorIs(t, priceService.RefreshID("bitcoin"), cg.ErrNotStarted)
+}
+
+func TestService_SimplePricesCacheOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCache := cache_mocks.NewMockICache(ctrl)
+
+	cfg := createTestConfig()
+	cfg.CoingeckoPrices.OnDemand = config.OnDemandPricesConfig{Enabled: true}
+	priceService := NewService(mockCache, cfg, nil, createMockTokensService(ctrl))
+
+	// Missing ethereum is not fetched on demand, no GetOrLoad is expected
+	mockCache.EXPECT().GetItems([]string{"price:id:bitcoin", "price:id:ethereum"}).Return(
+		cachedItems(map[string][]byte{"price:id:bitcoin": []byte(`{"usd": 50000}`)}),
+		[]string{"price:id:ethereum"},
+		nil,
+	)
+
+	response, meta, err := priceService.SimplePricesWithMeta(context.Background(), cg.PriceParams{
+		IDs:        []string{"bitcoin", "ethereum"},
+		Currencies: []string{"usd"},
+		CacheOnly:  true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cg.CacheStatusPartial, meta.CacheStatus)
+	assert.Contains(t, response, "bitcoin")
+	assert.NotContains(t, response, "ethereum")
+}
+
 func TestService_CacheKeys(t *testing.T) {
 	// Test cache key generation for individual token IDs
 	key1 := createTokenIDCacheKey("bitcoin")
@@ -158,7 +244,7 @@ func TestService_StartStop(t *testing.T) {
 
 	// Create mock cache service - expect possible cache operations from periodic updater
 	mockCache := cache_mocks.NewMockICache(ctrl)
-	mockCache.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Create test config
 	cfg := createTestConfig()
@@ -405,8 +491,8 @@ func TestService_SimplePricesAndTopPricesReturnSameFormat(t *testing.T) {
 	}
 
 	// Mock cache will be called once for SimplePrices and once for TopPrices (via SimplePrices)
-	mockCache.EXPECT().Get(expectedCacheKeys).Return(cachedData, []string{}, nil).Times(2)
-	mockCache.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockCache.EXPECT().GetItems(expectedCacheKeys).Return(cachedItems(cachedData), []string{}, nil).Times(2)
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Create mock markets service that returns the same IDs that we'll use in SimplePrices
 	mockMarketsService := mock_interfaces.NewMockIMarketsService(ctrl)
@@ -521,8 +607,8 @@ func TestService_SimplePricesAndTopPricesFormatConsistencyWithPartialData(t *tes
 	}
 	missingKeys := []string{"price:id:ethereum"}
 
-	mockCache.EXPECT().Get(expectedCacheKeys).Return(cachedData, missingKeys, nil).Times(2)
-	mockCache.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockCache.EXPECT().GetItems(expectedCacheKeys).Return(cachedItems(cachedData), missingKeys, nil).Times(2)
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Create mock markets service
 	mockMarketsService := mock_interfaces.NewMockIMarketsService(ctrl)
@@ -601,8 +687,8 @@ func TestService_SimplePricesAndTopPricesFormatConsistencyWithEmptyResponse(t *t
 	cachedData := map[string][]byte{} // no data
 	missingKeys := []string{"price:id:bitcoin", "price:id:ethereum"}
 
-	mockCache.EXPECT().Get(expectedCacheKeys).Return(cachedData, missingKeys, nil).Times(2)
-	mockCache.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockCache.EXPECT().GetItems(expectedCacheKeys).Return(cachedItems(cachedData), missingKeys, nil).Times(2)
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// Create mock markets service
 	mockMarketsService := mock_interfaces.NewMockIMarketsService(ctrl)
@@ -653,3 +739,204 @@ func TestService_SimplePricesAndTopPricesFormatConsistencyWithEmptyResponse(t *t
 	assert.IsType(t, cg.SimplePriceResponse{}, simplePricesResponse)
 	assert.IsType(t, cg.SimplePriceResponse{}, topPricesResponse)
 }
+
+func TestService_RestoreSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := snapshot.NewStore(snapshot.Config{Dir: t.TempDir()})
+	cfg := createTestConfig()
+	cfg.CoingeckoPrices.HardTTL = 2 * time.Hour
+
+	// Snapshot saved by a previous run, older than the tier update interval and the soft TTL.
+	// The second tier is older than the hard TTL and is not restored.
+	saved := map[string]tierSnapshot{
+		"top-1000": {
+			Data:      map[string]json.RawMessage{"bitcoin": json.RawMessage(`{"usd":50000}`)},
+			Timestamp: time.Now().Add(-time.Hour),
+		},
+		"top-1001-10000": {
+			Data:      map[string]json.RawMessage{"dai": json.RawMessage(`{"usd":1}`)},
+			Timestamp: time.Now().Add(-3 * time.Hour),
+		},
+	}
+	require.NoError(t, store.Save(snapshotName, saved))
+
+	mockMarketsService := mock_interfaces.NewMockIMarketsService(ctrl)
+	mockMarketsService.EXPECT().SubscribeTopMarketsUpdate().Return(events.NewSubscriptionManager().Subscribe()).AnyTimes()
+	mockMarketsService.EXPECT().SubscribeInitialized().Return(events.NewSubscriptionManager().Subscribe()).AnyTimes()
+	mockMarketsService.EXPECT().TopMarketIds(gomock.Any()).Return([]string{}, nil).AnyTimes()
+
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	priceService := NewService(cacheService, cfg, mockMarketsService, createMockTokensService(ctrl))
+	priceService.SetSnapshotStore(store)
+	subscription := priceService.SubscribeTopPricesUpdate()
+	defer subscription.Cancel()
+
+	require.NoError(t, priceService.Start(context.Background()))
+	defer priceService.Stop()
+
+	assert.True(t, priceService.Healthy())
+
+	// Restored prices are published as restored
+	restoredEvents := subscription.Events()
+	require.NotEmpty(t, restoredEvents)
+	assert.True(t, restoredEvents[0].Restored)
+	assert.Equal(t, []string{"bitcoin"}, restoredEvents[0].IDs)
+
+	response, cacheStatus, err := priceService.SimplePrices(context.Background(), cg.PriceParams{
+		IDs:        []string{"bitcoin"},
+		Currencies: []string{"usd"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cg.CacheStatusStale, cacheStatus)
+	assert.Equal(t, 50000.0, response["bitcoin"].(map[string]interface{})["usd"])
+	assert.Empty(t, priceService.periodicUpdater.GetCacheDataForTier("top-1001-10000"))
+}
+
+func TestService_HandleTierRestored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := createTestConfig()
+	cfg.CoingeckoPrices.TTL = time.Hour
+	cfg.CoingeckoPrices.HardTTL = 3 * time.Hour
+
+	// Restored prices are cached with their remaining soft and hard TTL
+	mockCache := cache_mocks.NewMockICache(ctrl)
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(data map[string][]byte, softTTL, hardTTL time.Duration) error {
+			assert.InDelta(t, (30 * time.Minute).Seconds(), softTTL.Seconds(), 5)
+			assert.InDelta(t, (150 * time.Minute).Seconds(), hardTTL.Seconds(), 5)
+			return nil
+		})
+	priceService := NewService(mockCache, cfg, nil, nil)
+	priceService.handleTierRestored(context.Background(), config.PriceTier{Name: "top-1000"},
+		map[string][]byte{"bitcoin": []byte(`{"usd": 50000}`)}, time.Now().Add(-30*time.Minute))
+
+	// Prices past soft TTL are stale right away
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), time.Nanosecond, gomock.Any()).Return(nil)
+	priceService.handleTierRestored(context.Background(), config.PriceTier{Name: "top-1000"},
+		map[string][]byte{"bitcoin": []byte(`{"usd": 50000}`)}, time.Now().Add(-2*time.Hour))
+}
+
+func TestService_SimplePricesServesStaleAndRefreshes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := createTestConfig()
+	cfg.CoingeckoPrices.TTL = 10 * time.Millisecond
+	cfg.CoingeckoPrices.HardTTL = time.Minute
+
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	priceService := NewService(cacheService, cfg, nil, nil)
+
+	mockUpdater := mock_coingecko_prices.NewMockIPeriodicUpdater(ctrl)
+	mockUpdater.EXPECT().HasStaleRestoredData().Return(false).AnyTimes()
+	priceService.periodicUpdater = mockUpdater
+
+	priceService.handleMissingExtraIdsUpdate(context.Background(), map[string][]byte{
+		"bitcoin": []byte(`{"usd": 50000}`),
+	})
+
+	params := cg.PriceParams{IDs: []string{"bitcoin"}, Currencies: []string{"usd"}}
+
+	_, meta, err := priceService.SimplePricesWithMeta(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, cg.CacheStatusFull, meta.CacheStatus)
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Past soft TTL prices are still served, marked stale and refreshed in background
+	mockUpdater.EXPECT().RefreshIds(gomock.Any(), []string{"bitcoin"}).Times(1)
+	response, meta, err := priceService.SimplePricesWithMeta(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, cg.CacheStatusStale, meta.CacheStatus)
+	assert.GreaterOrEqual(t, meta.Age, 10*time.Millisecond)
+	assert.Equal(t, 50000.0, response["bitcoin"].(map[string]interface{})["usd"])
+}
+
+func TestService_SimplePricesStaleKeepsPartialStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := createTestConfig()
+	cfg.CoingeckoPrices.TTL = 10 * time.Millisecond
+	cfg.CoingeckoPrices.HardTTL = time.Minute
+
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	priceService := NewService(cacheService, cfg, nil, nil)
+
+	mockUpdater := mock_coingecko_prices.NewMockIPeriodicUpdater(ctrl)
+	mockUpdater.EXPECT().HasStaleRestoredData().Return(false).AnyTimes()
+	mockUpdater.EXPECT().RefreshIds(gomock.Any(), []string{"bitcoin"}).Times(1)
+	priceService.periodicUpdater = mockUpdater
+
+	priceService.handleMissingExtraIdsUpdate(context.Background(), map[string][]byte{
+		"bitcoin": []byte(`{"usd": 50000}`),
+	})
+	time.Sleep(20 * time.Millisecond)
+
+	// Stale bitcoin doesn't hide that ethereum is missing
+	params := cg.PriceParams{IDs: []string{"bitcoin", "ethereum"}, Currencies: []string{"usd"}}
+	_, meta, err := priceService.SimplePricesWithMeta(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, cg.CacheStatusPartial, meta.CacheStatus)
+}
+
+func TestService_PricesUpdatePublishesChangedIds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCache := cache_mocks.NewMockICache(ctrl)
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	priceService := NewService(mockCache, createTestConfig(), nil, nil)
+	sub := priceService.SubscribeTopPricesUpdate()
+	defer sub.Cancel()
+
+	priceService.handleTopPricesUpdate(context.Background(), config.PriceTier{Name: "top-1000"}, map[string][]byte{
+		"bitcoin": []byte(`{"usd": 50000}`),
+	})
+
+	// Empty updates are not published
+	priceService.handleMissingExtraIdsUpdate(context.Background(), map[string][]byte{})
+
+	updates := sub.Events()
+	require.Len(t, updates, 1)
+	assert.Equal(t, "top-1000", updates[0].Tier)
+	assert.Equal(t, []string{"bitcoin"}, updates[0].IDs)
+}
+
+func TestService_OnMarketsUpdatedSkipsExtraIdsUpdates(t *testing.T) {
+	assert.True(t, hasTierUpdate([]events.Event{{Tier: "top-500", IDs: []string{"bitcoin"}}}))
+	assert.True(t, hasTierUpdate([]events.Event{{}}))
+	assert.False(t, hasTierUpdate([]events.Event{{IDs: []string{"bitcoin"}}}))
+}
+
+// recordedPrices collects prices passed to price history
+type recordedPrices struct {
+	updates []map[string][]byte
+}
+
+func (r *recordedPrices) RecordPrices(pricesData map[string][]byte) {
+	r.updates = append(r.updates, pricesData)
+}
+
+func TestService_TierUpdatesAreRecordedInPriceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCache := cache_mocks.NewMockICache(ctrl)
+	mockCache.EXPECT().SetWithSoftTTL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	priceService := NewService(mockCache, createTestConfig(), nil, nil)
+	history := &recordedPrices{}
+	priceService.SetPriceHistory(history)
+
+	tierPrices := map[string][]byte{"bitcoin": []byte(`{"usd": 50000}`)}
+	priceService.handleTopPricesUpdate(context.Background(), config.PriceTier{Name: "top-1000"}, tierPrices)
+	priceService.handleMissingExtraIdsUpdate(context.Background(), map[string][]byte{"extra": []byte(`{"usd": 1}`)})
+
+	assert.Equal(t, []map[string][]byte{tierPrices}, history.updates)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"sync/atomic"
 
 	"github.com/status-im/market-proxy/config"
@@ -16,6 +17,7 @@ type UpdatedCallback func(ctx context.Context, tokenLists map[string]*TokenList)
 // PeriodicUpdater handles periodic fetching and updating of token lists
 type PeriodicUpdater struct {
 	config        config.TokenListFetcherConfig
+	configMu      sync.RWMutex
 	client        IClient
 	metricsWriter *metrics.MetricsWriter
 	onUpdated     UpdatedCallback
@@ -29,17 +31,43 @@ func NewPeriodicUpdater(
 	metricsWriter *metrics.MetricsWriter,
 	onUpdated UpdatedCallback,
 ) *PeriodicUpdater {
-	return &PeriodicUpdater{
+	u := &PeriodicUpdater{
 		config:        config,
 		client:        client,
 		metricsWriter: metricsWriter,
 		onUpdated:     onUpdated,
 	}
+	// The scheduler is created upfront, so config reloads never race with Start
+	u.scheduler = scheduler.New(config.UpdateInterval, func(ctx context.Context) {
+		if err := u.fetchAndUpdate(ctx); err != nil {
+			log.Printf("Error updating token lists: %v", err)
+		} else {
+			u.initialized.Store(true)
+		}
+	})
+	return u
+}
+
+// getConfig returns the current fetcher config
+func (u *PeriodicUpdater) getConfig() config.TokenListFetcherConfig {
+	u.configMu.RLock()
+	defer u.configMu.RUnlock()
+	return u.config
+}
+
+// SetConfig replaces the fetcher config, the update interval is changed live
+// and supported platforms are fetched by the next update
+func (u *PeriodicUpdater) SetConfig(cfg config.TokenListFetcherConfig) {
+	u.configMu.Lock()
+	u.config = cfg
+	u.configMu.Unlock()
+
+	u.scheduler.SetInterval(cfg.UpdateInterval)
 }
 
 // Start begins periodic updates
 func (u *PeriodicUpdater) Start(ctx context.Context) error {
-	updateInterval := u.config.UpdateInterval
+	updateInterval := u.getConfig().UpdateInterval
 
 	// Skip periodic updates if interval is 0 or negative
 	if updateInterval <= 0 {
@@ -47,23 +75,13 @@ func (u *PeriodicUpdater) Start(ctx context.Context) error {
 		return nil
 	}
 
-	u.scheduler = scheduler.New(updateInterval, func(ctx context.Context) {
-		if err := u.fetchAndUpdate(ctx); err != nil {
-			log.Printf("Error updating token lists: %v", err)
-		} else {
-			u.initialized.Store(true)
-		}
-	})
-
 	u.scheduler.Start(ctx, true)
 
 	return nil
 }
 
 func (u *PeriodicUpdater) Stop() {
-	if u.scheduler != nil {
-		u.scheduler.Stop()
-	}
+	u.scheduler.Stop()
 }
 
 func (u *PeriodicUpdater) IsInitialized() bool {
@@ -78,7 +96,7 @@ func (u *PeriodicUpdater) fetchAndUpdate(ctx context.Context) error {
 	tokenLists := make(map[string]*TokenList)
 	var totalTokens int
 
-	for _, platform := range u.config.SupportedPlatforms {
+	for _, platform := range u.getConfig().SupportedPlatforms {
 		tokenList, err := u.client.FetchTokenList(platform)
 		if err != nil {
 			log.Printf("Failed to fetch token list for platform %s: %v", platform, err)
@@ -3,16 +3,19 @@ package coingecko_token_list
 import (
 	"context"
 	"fmt"
+	"log"
 	"sync"
 	"time"
 
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
 	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/response"
 )
 
 type Service struct {
 	config              *config.Config
+	configMu            sync.RWMutex
 	client              IClient
 	metricsWriter       *metrics.MetricsWriter
 	subscriptionManager *events.SubscriptionManager
@@ -44,13 +47,19 @@ func NewService(config *config.Config) *Service {
 
 // onTokenListsUpdated is the callback called when token lists are updated
 func (s *Service) onTokenListsUpdated(ctx context.Context, tokenLists map[string]*TokenList) error {
-	now := time.Now().Unix()
+	now := time.Now()
 
 	for platform, tokenList := range tokenLists {
+		tokenListResponse, err := response.NewSnapshot(tokenList, now)
+		if err != nil {
+			log.Printf("Failed to build token list response snapshot for platform %s: %v", platform, err)
+		}
+
 		s.cache.Store(platform, &TokenListCache{
 			Platform:  platform,
 			TokenList: *tokenList,
-			UpdatedAt: now,
+			UpdatedAt: now.Unix(),
+			Response:  tokenListResponse,
 		})
 	}
 
@@ -67,10 +76,70 @@ func (s *Service) Stop() {
 	s.periodicUpdater.Stop()
 }
 
+// getConfig returns the current config
+func (s *Service) getConfig() *config.Config {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+	return s.config
+}
+
+// ApplyConfig implements core.IReloadable: the update interval and supported platforms are applied live.
+// Token lists of platforms which are not supported anymore are dropped.
+func (s *Service) ApplyConfig(cfg *config.Config) {
+	s.configMu.Lock()
+	s.config = cfg
+	s.configMu.Unlock()
+
+	s.periodicUpdater.SetConfig(cfg.TokenListFetcher)
+
+	supported := make(map[string]bool, len(cfg.TokenListFetcher.SupportedPlatforms))
+	for _, platform := range cfg.TokenListFetcher.SupportedPlatforms {
+		supported[platform] = true
+	}
+
+	s.cache.Range(func(key, _ interface{}) bool {
+		if platform, ok := key.(string); ok && !supported[platform] {
+			log.Printf("Token list platform '%s' removed from config, dropping its data", platform)
+			s.cache.Delete(platform)
+		}
+		return true
+	})
+}
+
 // GetTokenList returns cached token list for a specific platform
 func (s *Service) GetTokenList(platform string) TokenListResponse {
+	tokenListCache, err := s.getTokenListCache(platform)
+	if err != nil {
+		return TokenListResponse{
+			TokenList: nil,
+			Error:     err,
+		}
+	}
+
+	tokenListCopy := tokenListCache.TokenList
+	return TokenListResponse{
+		TokenList: &tokenListCopy,
+		Error:     nil,
+	}
+}
+
+// GetTokenListSnapshot returns serialized cached token list for a specific platform
+func (s *Service) GetTokenListSnapshot(platform string) (*response.Snapshot, error) {
+	tokenListCache, err := s.getTokenListCache(platform)
+	if err != nil {
+		return nil, err
+	}
+	if tokenListCache.Response == nil {
+		return nil, fmt.Errorf("token list for platform '%s' not available", platform)
+	}
+
+	return tokenListCache.Response, nil
+}
+
+// getTokenListCache returns cached token list of a supported platform
+func (s *Service) getTokenListCache(platform string) (*TokenListCache, error) {
 	isSupported := false
-	for _, supportedPlatform := range s.config.TokenListFetcher.SupportedPlatforms {
+	for _, supportedPlatform := range s.getConfig().TokenListFetcher.SupportedPlatforms {
 		if supportedPlatform == platform {
 			isSupported = true
 			break
@@ -78,26 +147,16 @@ func (s *Service) GetTokenList(platform string) TokenListResponse {
 	}
 
 	if !isSupported {
-		return TokenListResponse{
-			TokenList: nil,
-			Error:     fmt.Errorf("platform '%s' is not supported", platform),
-		}
+		return nil, fmt.Errorf("platform '%s' is not supported", platform)
 	}
 
 	if value, exists := s.cache.Load(platform); exists {
 		if tokenListCache, ok := value.(*TokenListCache); ok {
-			tokenListCopy := tokenListCache.TokenList
-			return TokenListResponse{
-				TokenList: &tokenListCopy,
-				Error:     nil,
-			}
+			return tokenListCache, nil
 		}
 	}
 
-	return TokenListResponse{
-		TokenList: nil,
-		Error:     fmt.Errorf("token list for platform '%s' not available", platform),
-	}
+	return nil, fmt.Errorf("token list for platform '%s' not available", platform)
 }
 
 func (s *Service) Healthy() bool {
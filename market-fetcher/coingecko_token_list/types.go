@@ -1,5 +1,7 @@
 package coingecko_token_list
 
+import "github.com/status-im/market-proxy/response"
+
 // TokenListInfo represents basic information about a token in the token list
 type TokenListInfo struct {
 	ChainID  int    `json:"chainId"`
@@ -29,9 +31,10 @@ type TokenList struct {
 
 // TokenListCache represents cached token list data for a specific platform
 type TokenListCache struct {
-	Platform  string    `json:"platform"`
-	TokenList TokenList `json:"token_list"`
-	UpdatedAt int64     `json:"updated_at"`
+	Platform  string             `json:"platform"`
+	TokenList TokenList          `json:"token_list"`
+	UpdatedAt int64              `json:"updated_at"`
+	Response  *response.Snapshot `json:"-"` // serialized TokenList served by /token_lists/{platform}/all.json
 }
 
 // TokenListResponse represents the response from GetTokenList method
@@ -0,0 +1,84 @@
+package coingecko_tokens
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// ErrInvalidChecksum is returned for mixed-case hex addresses with an invalid EIP-55 checksum
+var ErrInvalidChecksum = errors.New("invalid EIP-55 address checksum")
+
+// NormalizeAddress returns the lookup form of a contract address.
+// Hex (EVM) addresses are lowercased, mixed-case ones must have a valid EIP-55 checksum.
+// Other addresses (e.g. base58) are case-sensitive and returned as is.
+func NormalizeAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if !isHexAddress(address) {
+		return address, nil
+	}
+
+	hexPart := address[2:]
+	lowerHex := strings.ToLower(hexPart)
+	// All-lowercase and all-uppercase addresses carry no checksum
+	if hexPart != lowerHex && hexPart != strings.ToUpper(hexPart) && checksumHex(lowerHex) != hexPart {
+		return "", ErrInvalidChecksum
+	}
+
+	return "0x" + lowerHex, nil
+}
+
+// ChecksumAddress returns the EIP-55 mixed-case form of a hex address
+func ChecksumAddress(address string) string {
+	if !isHexAddress(address) {
+		return address
+	}
+	return "0x" + checksumHex(strings.ToLower(address[2:]))
+}
+
+// indexAddress returns the address form stored in the address index
+func indexAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if isHexAddress(address) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// checksumHex applies EIP-55 capitalization to 40 lowercase hex characters
+func checksumHex(lowerHex string) string {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(lowerHex))
+	hash := hasher.Sum(nil)
+
+	result := []byte(lowerHex)
+	for i, char := range result {
+		if char < 'a' || char > 'f' {
+			continue
+		}
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if nibble&0x0f >= 8 {
+			result[i] = char - 'a' + 'A'
+		}
+	}
+	return string(result)
+}
+
+// isHexAddress returns true for 0x-prefixed 20 bytes hex addresses
+func isHexAddress(address string) bool {
+	if len(address) != 42 || (address[:2] != "0x" && address[:2] != "0X") {
+		return false
+	}
+	for _, char := range address[2:] {
+		isDigit := char >= '0' && char <= '9'
+		isHexLetter := (char >= 'a' && char <= 'f') || (char >= 'A' && char <= 'F')
+		if !isDigit && !isHexLetter {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package coingecko_tokens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumAddress(t *testing.T) {
+	// Test vectors from EIP-55
+	addresses := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+
+	for _, address := range addresses {
+		assert.Equal(t, address, ChecksumAddress(address))
+	}
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	normalized, err := NormalizeAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	require.NoError(t, err)
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", normalized)
+
+	normalized, err = NormalizeAddress(" 0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED ")
+	require.NoError(t, err)
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", normalized)
+
+	_, err = NormalizeAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	assert.ErrorIs(t, err, ErrInvalidChecksum)
+
+	// Non-hex addresses are case-sensitive
+	normalized, err = NormalizeAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	require.NoError(t, err)
+	assert.Equal(t, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", normalized)
+}
@@ -4,26 +4,48 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/status-im/market-proxy/interfaces"
 
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/metrics"
 	"github.com/status-im/market-proxy/scheduler"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
+const snapshotName = "tokens"
+
+// tokensSnapshot is a persisted form of the last fetched tokens
+type tokensSnapshot struct {
+	Tokens    []interfaces.Token `json:"tokens"`
+	Timestamp time.Time          `json:"timestamp"`
+}
+
 // UpdatedCallback is called when tokens are successfully updated
 type UpdatedCallback func(ctx context.Context, tokens []interfaces.Token) error
 
 // PeriodicUpdater handles periodic fetching and updating of tokens
 type PeriodicUpdater struct {
 	config        config.CoinslistFetcherConfig
+	configMu      sync.RWMutex
 	client        *Client
 	metricsWriter *metrics.MetricsWriter
 	onUpdated     UpdatedCallback
 	scheduler     *scheduler.Scheduler
 	initialized   atomic.Bool
+	restored      atomic.Bool
+	snapshotStore *snapshot.Store
+	snapshotSaver *snapshot.Saver
+
+	// Last fetched tokens kept for snapshots
+	last struct {
+		sync.RWMutex
+		tokens    []interfaces.Token
+		timestamp time.Time
+	}
 }
 
 // NewPeriodicUpdater creates a new periodic updater
@@ -33,17 +55,52 @@ func NewPeriodicUpdater(
 	metricsWriter *metrics.MetricsWriter,
 	onUpdated UpdatedCallback,
 ) *PeriodicUpdater {
-	return &PeriodicUpdater{
+	u := &PeriodicUpdater{
 		config:        config,
 		client:        client,
 		metricsWriter: metricsWriter,
 		onUpdated:     onUpdated,
 	}
+	// The scheduler is created upfront, so config reloads never race with Start
+	u.scheduler = scheduler.New(config.UpdateInterval, func(ctx context.Context) {
+		if err := u.fetchAndUpdate(ctx); err != nil {
+			log.Printf("Error updating tokens: %v", err)
+		} else {
+			u.initialized.Store(true)
+		}
+	})
+	return u
+}
+
+// getConfig returns the current fetcher config
+func (u *PeriodicUpdater) getConfig() config.CoinslistFetcherConfig {
+	u.configMu.RLock()
+	defer u.configMu.RUnlock()
+	return u.config
+}
+
+// SetConfig replaces the fetcher config, the update interval is changed live
+// and supported platforms are applied by the next update
+func (u *PeriodicUpdater) SetConfig(cfg config.CoinslistFetcherConfig) {
+	u.configMu.Lock()
+	u.config = cfg
+	u.configMu.Unlock()
+
+	u.scheduler.SetInterval(cfg.UpdateInterval)
+}
+
+// SetSnapshotStore enables warm-start snapshots of tokens
+func (u *PeriodicUpdater) SetSnapshotStore(store *snapshot.Store) {
+	u.snapshotStore = store
 }
 
 // Start begins periodic updates
 func (u *PeriodicUpdater) Start(ctx context.Context) error {
-	updateInterval := u.config.UpdateInterval
+	updateInterval := u.getConfig().UpdateInterval
+
+	lastUpdate := u.restoreSnapshot(ctx)
+	u.snapshotSaver = snapshot.NewSaver(u.snapshotStore, snapshotName, u.snapshot)
+	u.snapshotSaver.Start(ctx)
 
 	// Skip periodic updates if interval is 0 or negative
 	if updateInterval <= 0 {
@@ -51,23 +108,18 @@ func (u *PeriodicUpdater) Start(ctx context.Context) error {
 		return nil
 	}
 
-	u.scheduler = scheduler.New(updateInterval, func(ctx context.Context) {
-		if err := u.fetchAndUpdate(ctx); err != nil {
-			log.Printf("Error updating tokens: %v", err)
-		} else {
-			u.initialized.Store(true)
-		}
-	})
-
-	u.scheduler.Start(ctx, true)
+	// Skip immediate fetch if restored tokens are still fresh
+	fresh := !lastUpdate.IsZero() && time.Since(lastUpdate) < updateInterval
+	u.scheduler.Start(ctx, !fresh)
 
 	return nil
 }
 
 // Stop stops periodic updates
 func (u *PeriodicUpdater) Stop() {
-	if u.scheduler != nil {
-		u.scheduler.Stop()
+	u.scheduler.Stop()
+	if u.snapshotSaver != nil {
+		u.snapshotSaver.Stop()
 	}
 }
 
@@ -76,6 +128,55 @@ func (u *PeriodicUpdater) IsInitialized() bool {
 	return u.initialized.Load()
 }
 
+// IsRestored returns true if tokens were restored from snapshot
+func (u *PeriodicUpdater) IsRestored() bool {
+	return u.restored.Load()
+}
+
+// restoreSnapshot loads tokens saved by a previous run and passes them to the callback
+// Returns the time of the restored update or zero time if nothing was restored
+func (u *PeriodicUpdater) restoreSnapshot(ctx context.Context) time.Time {
+	var saved tokensSnapshot
+	found, err := u.snapshotStore.Load(snapshotName, &saved)
+	if err != nil {
+		log.Printf("Failed to restore tokens snapshot: %v", err)
+		return time.Time{}
+	}
+	if !found || len(saved.Tokens) == 0 {
+		return time.Time{}
+	}
+
+	u.setLast(saved.Tokens, saved.Timestamp)
+	if u.onUpdated != nil {
+		if err := u.onUpdated(ctx, saved.Tokens); err != nil {
+			log.Printf("Failed to apply restored tokens: %v", err)
+			return time.Time{}
+		}
+	}
+	u.restored.Store(true)
+
+	log.Printf("Restored %d tokens from snapshot (updated at %v)", len(saved.Tokens), saved.Timestamp.Format(time.RFC3339))
+	return saved.Timestamp
+}
+
+// snapshot returns last fetched tokens to persist
+func (u *PeriodicUpdater) snapshot() (interface{}, error) {
+	u.last.RLock()
+	defer u.last.RUnlock()
+
+	if len(u.last.tokens) == 0 {
+		return nil, nil
+	}
+	return tokensSnapshot{Tokens: u.last.tokens, Timestamp: u.last.timestamp}, nil
+}
+
+func (u *PeriodicUpdater) setLast(tokens []interfaces.Token, timestamp time.Time) {
+	u.last.Lock()
+	defer u.last.Unlock()
+	u.last.tokens = tokens
+	u.last.timestamp = timestamp
+}
+
 // fetchAndUpdate fetches tokens from API and calls the callback
 func (u *PeriodicUpdater) fetchAndUpdate(ctx context.Context) error {
 	u.metricsWriter.ResetCycleMetrics()
@@ -86,13 +187,15 @@ func (u *PeriodicUpdater) fetchAndUpdate(ctx context.Context) error {
 		return fmt.Errorf("failed to fetch tokens: %w", err)
 	}
 
-	filteredTokens := FilterTokensByPlatform(tokens, u.config.SupportedPlatforms)
+	filteredTokens := FilterTokensByPlatform(tokens, u.getConfig().SupportedPlatforms)
 
 	tokensByPlatform := CountTokensByPlatform(filteredTokens)
 
 	u.metricsWriter.RecordCacheSize(len(filteredTokens))
 	metrics.RecordTokensByPlatform(tokensByPlatform)
 
+	u.setLast(filteredTokens, time.Now())
+
 	// Call the callback with updated tokens
 	if u.onUpdated != nil {
 		if err := u.onUpdated(ctx, filteredTokens); err != nil {
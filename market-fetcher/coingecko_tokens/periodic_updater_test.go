@@ -10,6 +10,7 @@ import (
 
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 func TestNewPeriodicUpdater(t *testing.T) {
@@ -179,9 +180,9 @@ func TestPeriodicUpdater_Start_DisabledInterval(t *testing.T) {
 		t.Errorf("Expected no error when interval is disabled, got %v", err)
 	}
 
-	// Scheduler should be nil when interval is disabled
-	if updater.scheduler != nil {
-		t.Error("Expected scheduler to be nil when interval is disabled")
+	// Scheduler should not run when interval is disabled
+	if updater.scheduler.IsRunning() {
+		t.Error("Expected scheduler not to run when interval is disabled")
 	}
 }
 
@@ -199,7 +200,7 @@ func TestPeriodicUpdater_Stop(t *testing.T) {
 
 	updater := NewPeriodicUpdater(cfg, &Client{}, metricsWriter, callback)
 
-	// Test stop when scheduler is nil
+	// Test stop when scheduler is not started
 	updater.Stop()
 
 	// Should not panic and complete successfully
@@ -255,3 +256,65 @@ func TestPeriodicUpdater_CallbackParameters(t *testing.T) {
 		t.Errorf("Expected token ID %s, got %s", testTokens[0].ID, receivedTokens[0].ID)
 	}
 }
+
+func TestPeriodicUpdater_RestoreSnapshot(t *testing.T) {
+	store := snapshot.NewStore(snapshot.Config{Dir: t.TempDir()})
+	saved := tokensSnapshot{
+		Tokens:    []interfaces.Token{{ID: "usd-coin", Symbol: "usdc", Name: "USDC", Platforms: map[string]string{"ethereum": "0xa0b8"}}},
+		Timestamp: time.Now(),
+	}
+	if err := store.Save(snapshotName, saved); err != nil {
+		t.Fatalf("Failed to save snapshot: %v", err)
+	}
+
+	var received []interfaces.Token
+	callback := func(ctx context.Context, tokens []interfaces.Token) error {
+		received = tokens
+		return nil
+	}
+
+	cfg := config.CoinslistFetcherConfig{UpdateInterval: time.Hour}
+	updater := NewPeriodicUpdater(cfg, &Client{}, metrics.NewMetricsWriter(metrics.ServiceCoins), callback)
+	updater.SetSnapshotStore(store)
+
+	lastUpdate := updater.restoreSnapshot(context.Background())
+
+	if !updater.IsRestored() || updater.IsInitialized() {
+		t.Error("Expected updater to be restored but not initialized")
+	}
+	if !lastUpdate.Equal(saved.Timestamp) {
+		t.Errorf("Expected last update %v, got %v", saved.Timestamp, lastUpdate)
+	}
+	if len(received) != 1 || received[0].ID != "usd-coin" {
+		t.Errorf("Expected restored tokens to be passed to callback, got %v", received)
+	}
+
+	state, err := updater.snapshot()
+	if err != nil || state == nil {
+		t.Fatalf("Expected snapshot state, got %v (err: %v)", state, err)
+	}
+}
+
+func TestPeriodicUpdater_SetConfig(t *testing.T) {
+	cfg := config.CoinslistFetcherConfig{
+		UpdateInterval:     30 * time.Second,
+		SupportedPlatforms: []string{"ethereum"},
+	}
+
+	metricsWriter := metrics.NewMetricsWriter(metrics.ServiceCoins)
+	updater := NewPeriodicUpdater(cfg, &Client{}, metricsWriter, nil)
+
+	// Config can be replaced before the updater is started
+	updater.SetConfig(config.CoinslistFetcherConfig{
+		UpdateInterval:     time.Minute,
+		SupportedPlatforms: []string{"ethereum", "polygon-pos"},
+	})
+
+	reloaded := updater.getConfig()
+	if reloaded.UpdateInterval != time.Minute {
+		t.Errorf("Expected update interval 1m, got %v", reloaded.UpdateInterval)
+	}
+	if len(reloaded.SupportedPlatforms) != 2 {
+		t.Errorf("Expected 2 supported platforms, got %d", len(reloaded.SupportedPlatforms))
+	}
+}
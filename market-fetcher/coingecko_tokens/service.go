@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/status-im/market-proxy/interfaces"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
 	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/response"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 type Service struct {
@@ -20,8 +23,10 @@ type Service struct {
 	subscriptionManager *events.SubscriptionManager
 	cache               struct {
 		sync.RWMutex
-		tokens   []interfaces.Token
-		tokenIds []string
+		tokens       []interfaces.Token
+		tokenIds     []string
+		addressIndex map[string]map[string]string // platform -> index address -> token ID
+		response     *response.Snapshot           // serialized tokens served by /coins/list
 	}
 	periodicUpdater *PeriodicUpdater
 }
@@ -63,9 +68,23 @@ func (s *Service) onTokensUpdated(ctx context.Context, tokens []interfaces.Token
 		}
 	}
 
+	addressIndex := buildAddressIndex(tokens)
+
+	// Serialize tokens once per update instead of on every /coins/list request
+	var tokensResponse *response.Snapshot
+	if len(tokens) > 0 {
+		serialized, err := response.NewSnapshot(tokens, time.Now())
+		if err != nil {
+			log.Printf("Failed to build tokens response snapshot: %v", err)
+		}
+		tokensResponse = serialized
+	}
+
 	s.cache.Lock()
 	s.cache.tokens = tokens
 	s.cache.tokenIds = tokenIds
+	s.cache.addressIndex = addressIndex
+	s.cache.response = tokensResponse
 	tokensCount := len(s.cache.tokens)
 	tokenIdsCount := len(s.cache.tokenIds)
 	s.cache.Unlock()
@@ -78,6 +97,11 @@ func (s *Service) onTokensUpdated(ctx context.Context, tokens []interfaces.Token
 	return nil
 }
 
+// SetSnapshotStore enables warm-start snapshots of tokens
+func (s *Service) SetSnapshotStore(store *snapshot.Store) {
+	s.periodicUpdater.SetSnapshotStore(store)
+}
+
 func (s *Service) Start(ctx context.Context) error {
 	return s.periodicUpdater.Start(ctx)
 }
@@ -86,6 +110,11 @@ func (s *Service) Stop() {
 	s.periodicUpdater.Stop()
 }
 
+// ApplyConfig implements core.IReloadable: the update interval and supported platforms are applied live
+func (s *Service) ApplyConfig(cfg *config.Config) {
+	s.periodicUpdater.SetConfig(cfg.TokensFetcher)
+}
+
 // GetTokens returns cached tokens
 func (s *Service) GetTokens() []interfaces.Token {
 	s.cache.RLock()
@@ -97,6 +126,14 @@ func (s *Service) GetTokens() []interfaces.Token {
 	return tokensCopy
 }
 
+// GetTokensSnapshot returns serialized cached tokens, nil if there are no tokens
+func (s *Service) GetTokensSnapshot() *response.Snapshot {
+	s.cache.RLock()
+	defer s.cache.RUnlock()
+
+	return s.cache.response
+}
+
 // GetTokenIds returns cached token IDs
 func (s *Service) GetTokenIds() []string {
 	s.cache.RLock()
@@ -108,13 +145,49 @@ func (s *Service) GetTokenIds() []string {
 	return tokenIdsCopy
 }
 
-// Healthy checks if service is initialized and has data
+// TokenIDByAddress returns ID of the token with the contract address on the platform.
+// Hex addresses are matched case-insensitively.
+func (s *Service) TokenIDByAddress(platform, address string) (string, bool) {
+	s.cache.RLock()
+	defer s.cache.RUnlock()
+
+	tokenID, ok := s.cache.addressIndex[platform][indexAddress(address)]
+	return tokenID, ok
+}
+
+// buildAddressIndex builds the platform and contract address to token ID index.
+// If several tokens share an address, the first one wins.
+func buildAddressIndex(tokens []interfaces.Token) map[string]map[string]string {
+	index := make(map[string]map[string]string)
+	for _, token := range tokens {
+		for platform, address := range token.Platforms {
+			if address == "" || token.ID == "" {
+				continue
+			}
+
+			platformIndex, ok := index[platform]
+			if !ok {
+				platformIndex = make(map[string]string)
+				index[platform] = platformIndex
+			}
+
+			key := indexAddress(address)
+			if _, exists := platformIndex[key]; !exists {
+				platformIndex[key] = token.ID
+			}
+		}
+	}
+	return index
+}
+
+// Healthy checks if service is initialized (or restored from snapshot) and has data
 func (s *Service) Healthy() bool {
 	s.cache.RLock()
 	tokensLen := len(s.cache.tokens)
 	s.cache.RUnlock()
 
-	return s.periodicUpdater.IsInitialized() && tokensLen > 0
+	ready := s.periodicUpdater.IsInitialized() || s.periodicUpdater.IsRestored()
+	return ready && tokensLen > 0
 }
 
 func (s *Service) SubscribeOnTokensUpdate() events.ISubscription {
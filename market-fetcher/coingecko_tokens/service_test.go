@@ -0,0 +1,57 @@
+package coingecko_tokens
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+func TestService_TokenIDByAddress(t *testing.T) {
+	service := NewService(&config.Config{})
+	err := service.onTokensUpdated(context.Background(), []interfaces.Token{
+		{ID: "usd-coin", Platforms: map[string]string{
+			"ethereum": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+			"base":     "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913",
+		}},
+		{ID: "bridged-usdc", Platforms: map[string]string{"ethereum": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"}},
+		{ID: "ethereum", Platforms: map[string]string{}},
+	})
+	require.NoError(t, err)
+
+	tokenID, ok := service.TokenIDByAddress("ethereum", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	assert.True(t, ok)
+	assert.Equal(t, "usd-coin", tokenID, "the first token with the address wins")
+
+	tokenID, ok = service.TokenIDByAddress("base", "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")
+	assert.True(t, ok)
+	assert.Equal(t, "usd-coin", tokenID)
+
+	_, ok = service.TokenIDByAddress("arbitrum-one", "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913")
+	assert.False(t, ok)
+}
+
+func TestService_GetTokensSnapshot(t *testing.T) {
+	service := NewService(&config.Config{})
+	assert.Nil(t, service.GetTokensSnapshot())
+
+	tokens := []interfaces.Token{
+		{ID: "bitcoin", Symbol: "btc", Name: "Bitcoin", Platforms: map[string]string{}},
+		{ID: "usd-coin", Symbol: "usdc", Name: "USDC", Platforms: map[string]string{"ethereum": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"}},
+	}
+	require.NoError(t, service.onTokensUpdated(context.Background(), tokens))
+
+	tokensSnapshot := service.GetTokensSnapshot()
+	require.NotNil(t, tokensSnapshot)
+	expected, err := json.Marshal(tokens)
+	require.NoError(t, err)
+	assert.Equal(t, expected, tokensSnapshot.Body())
+
+	require.NoError(t, service.onTokensUpdated(context.Background(), nil))
+	assert.Nil(t, service.GetTokensSnapshot())
+}
@@ -0,0 +1,53 @@
+package coingecko_trending
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/fetcher_endpoint"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+const (
+	TRENDING_API_PATH = "/api/v3/search/trending"
+)
+
+// Service periodically fetches trending coins, NFTs and categories
+type Service struct {
+	*fetcher_endpoint.Fetcher
+}
+
+func NewService(config *config.Config) *Service {
+	return &Service{
+		Fetcher: fetcher_endpoint.NewFetcher(config, fetcher_endpoint.Options{
+			Name:           "Trending",
+			Path:           TRENDING_API_PATH,
+			MetricsService: metrics.ServiceTrending,
+			UpdateInterval: getUpdateInterval,
+			Validate:       validateTrending,
+		}),
+	}
+}
+
+// Trending returns the cached /search/trending response and the time it was fetched, nil if not fetched yet
+func (s *Service) Trending() (json.RawMessage, time.Time) {
+	return s.Data()
+}
+
+func getUpdateInterval(cfg *config.Config) time.Duration {
+	return cfg.CoingeckoTrending.GetUpdateInterval()
+}
+
+// validateTrending rejects responses without trending coins
+func validateTrending(data json.RawMessage) error {
+	var response TrendingResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+	if len(response.Coins) == 0 {
+		return fmt.Errorf("trending response is empty")
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package coingecko_trending
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTrending(t *testing.T) {
+	assert.NoError(t, validateTrending(json.RawMessage(`{"coins":[{"item":{"id":"bitcoin"}}]}`)))
+	assert.Error(t, validateTrending(json.RawMessage(`{"coins":[]}`)))
+	assert.Error(t, validateTrending(json.RawMessage(`[]`)))
+}
@@ -0,0 +1,9 @@
+package coingecko_trending
+
+import "encoding/json"
+
+// TrendingResponse contains the fields of CoinGecko /api/v3/search/trending response used to validate it,
+// the response is served as is
+type TrendingResponse struct {
+	Coins []json.RawMessage `json:"coins"`
+}
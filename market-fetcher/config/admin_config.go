@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"net"
+)
+
+// AdminConfig represents configuration for the admin API listener
+type AdminConfig struct {
+	Host  string `yaml:"host"`  // Interface of the admin listener, localhost by default
+	Port  string `yaml:"port"`  // Port of the admin listener, separate from the public API
+	Token string `yaml:"token"` // Bearer token required by admin requests, empty disables the listener
+}
+
+// Enabled returns true if the admin listener should be started
+func (c *AdminConfig) Enabled() bool {
+	return c.Token != ""
+}
+
+// GetHost returns the admin listener host or default value
+func (c *AdminConfig) GetHost() string {
+	if c.Host != "" {
+		return c.Host
+	}
+
+	return "127.0.0.1"
+}
+
+// GetPort returns the admin listener port or default value
+func (c *AdminConfig) GetPort() string {
+	if c.Port != "" {
+		return c.Port
+	}
+
+	return "9091"
+}
+
+// Addr returns the admin listener address
+func (c *AdminConfig) Addr() string {
+	return net.JoinHostPort(c.GetHost(), c.GetPort())
+}
+
+// Validate checks that the admin listener does not share the port of the public API
+func (c *AdminConfig) Validate(publicPort string) error {
+	if c.Enabled() && c.GetPort() == publicPort {
+		return fmt.Errorf("admin port %s must differ from the public API port", c.GetPort())
+	}
+
+	return nil
+}
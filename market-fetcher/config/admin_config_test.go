@@ -0,0 +1,22 @@
+package config
+
+import "testing"
+
+func TestAdminConfig_Validate(t *testing.T) {
+	cfg := AdminConfig{Token: "secret"}
+	if cfg.Addr() != "127.0.0.1:9091" {
+		t.Errorf("Addr() = %s, expected 127.0.0.1:9091", cfg.Addr())
+	}
+	if err := cfg.Validate("8080"); err != nil {
+		t.Errorf("Validate() unexpected error = %v", err)
+	}
+	if err := cfg.Validate("9091"); err == nil {
+		t.Errorf("Validate() expected error for admin port equal to public port")
+	}
+
+	// Disabled admin API does not bind the port
+	disabled := AdminConfig{Port: "8080"}
+	if err := disabled.Validate("8080"); err != nil {
+		t.Errorf("Validate() unexpected error = %v", err)
+	}
+}
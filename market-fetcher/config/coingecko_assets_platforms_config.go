@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+// AssetsPlatformsFetcherConfig represents configuration for CoinGecko asset platforms service
+type AssetsPlatformsFetcherConfig struct {
+	UpdateInterval time.Duration    `yaml:"update_interval"` // Interval between asset platforms list updates
+	ChainIDs       map[string]int64 `yaml:"chain_ids"`       // Chain IDs of platforms which have no chain_identifier upstream
+}
+
+// GetUpdateInterval returns the update interval or default value
+func (c *AssetsPlatformsFetcherConfig) GetUpdateInterval() time.Duration {
+	if c.UpdateInterval > 0 {
+		return c.UpdateInterval
+	}
+
+	return 30 * time.Minute
+}
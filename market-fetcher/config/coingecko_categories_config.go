@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+// CategoriesFetcherConfig represents configuration for CoinGecko categories list service
+type CategoriesFetcherConfig struct {
+	UpdateInterval time.Duration `yaml:"update_interval"` // Interval between categories list updates
+}
+
+// GetUpdateInterval returns the update interval or default value
+func (c *CategoriesFetcherConfig) GetUpdateInterval() time.Duration {
+	if c.UpdateInterval > 0 {
+		return c.UpdateInterval
+	}
+
+	return time.Hour
+}
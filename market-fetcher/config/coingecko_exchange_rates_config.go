@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+// ExchangeRatesFetcherConfig represents configuration for CoinGecko exchange rates service
+type ExchangeRatesFetcherConfig struct {
+	UpdateInterval time.Duration `yaml:"update_interval"` // Interval between exchange rates updates
+}
+
+// GetUpdateInterval returns the update interval or default value
+func (c *ExchangeRatesFetcherConfig) GetUpdateInterval() time.Duration {
+	if c.UpdateInterval > 0 {
+		return c.UpdateInterval
+	}
+
+	return 10 * time.Minute
+}
@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+// GlobalFetcherConfig represents configuration for CoinGecko global market data service
+type GlobalFetcherConfig struct {
+	UpdateInterval time.Duration `yaml:"update_interval"` // Interval between /global updates
+}
+
+// GetUpdateInterval returns the update interval or default value
+func (c *GlobalFetcherConfig) GetUpdateInterval() time.Duration {
+	if c.UpdateInterval > 0 {
+		return c.UpdateInterval
+	}
+
+	return 5 * time.Minute
+}
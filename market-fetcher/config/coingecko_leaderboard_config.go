@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -17,6 +18,26 @@ type LeaderboardFetcherConfig struct {
 type APITokens struct {
 	Tokens     []string `json:"api_tokens"`
 	DemoTokens []string `json:"demo_api_tokens,omitempty"`
+	mu         sync.RWMutex
+}
+
+// Keys returns copies of Pro and Demo tokens, safe to call while tokens are replaced
+func (t *APITokens) Keys() (tokens []string, demoTokens []string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]string{}, t.Tokens...), append([]string{}, t.DemoTokens...)
+}
+
+// Replace sets tokens from other in place, so that every key manager sharing t sees rotated keys
+func (t *APITokens) Replace(other *APITokens) {
+	tokens, demoTokens := other.Keys()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Tokens = tokens
+	t.DemoTokens = demoTokens
 }
 
 func LoadAPITokens(filename string) (*APITokens, error) {
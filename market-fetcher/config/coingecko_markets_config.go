@@ -23,6 +23,8 @@ type MarketTier struct {
 	PageTo            int           `yaml:"page_to"`             // End of token page (inclusive)
 	UpdateInterval    time.Duration `yaml:"update_interval"`     // Update interval for this tier
 	FetchCoinslistIds bool          `yaml:"fetch_coinslist_ids"` // Whether to fetch missing coinslist IDs for supported platforms after main fetch
+	Category          string        `yaml:"category"`            // CoinGecko category ID, empty for top markets of all coins
+	Sparkline         bool          `yaml:"sparkline"`           // Whether to fetch 7d sparkline data, it is cached separately from markets data
 }
 
 type MarketsFetcherConfig struct {
@@ -47,13 +49,17 @@ func (c *MarketsFetcherConfig) validateTiers() error {
 		return fmt.Errorf("at least one tier must be configured")
 	}
 
-	// Create a copy of tiers and sort by PageFrom for easier validation
+	// Create a copy of tiers and sort by category and PageFrom for easier validation
 	tiers := make([]MarketTier, len(c.Tiers))
 	copy(tiers, c.Tiers)
 	sort.Slice(tiers, func(i, j int) bool {
+		if tiers[i].Category != tiers[j].Category {
+			return tiers[i].Category < tiers[j].Category
+		}
 		return tiers[i].PageFrom < tiers[j].PageFrom
 	})
 
+	names := make(map[string]bool, len(tiers))
 	for i, tier := range tiers {
 		// Validate individual tier
 		if tier.Name == "" {
@@ -69,8 +75,13 @@ func (c *MarketsFetcherConfig) validateTiers() error {
 			return fmt.Errorf("tier '%s': update_interval must be greater than 0", tier.Name)
 		}
 
-		// Check for overlaps with previous tier
-		if i > 0 {
+		if names[tier.Name] {
+			return fmt.Errorf("tier '%s': name must be unique", tier.Name)
+		}
+		names[tier.Name] = true
+
+		// Check for overlaps with previous tier of the same category
+		if i > 0 && tiers[i-1].Category == tier.Category {
 			prevTier := tiers[i-1]
 			if tier.PageFrom <= prevTier.PageTo {
 				return fmt.Errorf("tier '%s' page [%d-%d] overlaps with tier '%s' page [%d-%d]",
@@ -90,3 +101,27 @@ func (c *MarketsFetcherConfig) GetTTL() time.Duration {
 
 	return 30 * time.Minute
 }
+
+// GetMinUpdateInterval returns the shortest tier update interval, TTL if no tiers are configured
+func (c *MarketsFetcherConfig) GetMinUpdateInterval() time.Duration {
+	interval := c.GetTTL()
+	for _, tier := range c.Tiers {
+		if tier.UpdateInterval > 0 && tier.UpdateInterval < interval {
+			interval = tier.UpdateInterval
+		}
+	}
+	return interval
+}
+
+// GetCategories returns categories of configured category tiers
+func (c *MarketsFetcherConfig) GetCategories() []string {
+	var categories []string
+	seen := make(map[string]bool)
+	for _, tier := range c.Tiers {
+		if tier.Category != "" && !seen[tier.Category] {
+			seen[tier.Category] = true
+			categories = append(categories, tier.Category)
+		}
+	}
+	return categories
+}
@@ -116,6 +116,39 @@ func TestCoingeckoMarketsFetcher_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "update_interval must be greater than 0",
 		},
+		{
+			name: "category tiers may overlap top tiers",
+			config: MarketsFetcherConfig{
+				Tiers: []MarketTier{
+					{Name: "top", PageFrom: 1, PageTo: 2, UpdateInterval: 30 * time.Second},
+					{Name: "defi", Category: "decentralized-finance-defi", PageFrom: 1, PageTo: 1, UpdateInterval: 5 * time.Minute},
+					{Name: "layer-2", Category: "layer-2", PageFrom: 1, PageTo: 2, UpdateInterval: 5 * time.Minute},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "overlapping tiers of the same category",
+			config: MarketsFetcherConfig{
+				Tiers: []MarketTier{
+					{Name: "layer-2", Category: "layer-2", PageFrom: 1, PageTo: 2, UpdateInterval: 5 * time.Minute},
+					{Name: "layer-2-more", Category: "layer-2", PageFrom: 2, PageTo: 3, UpdateInterval: 5 * time.Minute},
+				},
+			},
+			wantErr: true,
+			errMsg:  "overlaps",
+		},
+		{
+			name: "duplicate tier names",
+			config: MarketsFetcherConfig{
+				Tiers: []MarketTier{
+					{Name: "tier1", PageFrom: 1, PageTo: 1, UpdateInterval: 30 * time.Second},
+					{Name: "tier1", Category: "layer-2", PageFrom: 1, PageTo: 1, UpdateInterval: 5 * time.Minute},
+				},
+			},
+			wantErr: true,
+			errMsg:  "must be unique",
+		},
 	}
 
 	for _, tt := range tests {
@@ -138,3 +171,19 @@ func TestCoingeckoMarketsFetcher_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketsFetcherConfig_GetCategories(t *testing.T) {
+	cfg := MarketsFetcherConfig{
+		Tiers: []MarketTier{
+			{Name: "top"},
+			{Name: "layer-2", Category: "layer-2"},
+			{Name: "defi", Category: "decentralized-finance-defi"},
+			{Name: "layer-2-more", Category: "layer-2"},
+		},
+	}
+
+	categories := cfg.GetCategories()
+	if len(categories) != 2 || categories[0] != "layer-2" || categories[1] != "decentralized-finance-defi" {
+		t.Errorf("GetCategories() = %v", categories)
+	}
+}
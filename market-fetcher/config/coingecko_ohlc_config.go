@@ -0,0 +1,45 @@
+package config
+
+import "time"
+
+// OHLCFetcherConfig defines configuration for CoinGecko OHLC service
+type OHLCFetcherConfig struct {
+	// HourlyTTL is the cache TTL for candles of up to 4 hours (requests with days <= 30)
+	HourlyTTL time.Duration `yaml:"hourly_ttl"`
+
+	// DailyTTL is the cache TTL for 4 days candles (requests with days > 30)
+	DailyTTL time.Duration `yaml:"daily_ttl"`
+
+	// PlanIntervals lists explicit intervals ("hourly", "daily") provided by the upstream plan,
+	// candles with other intervals are built locally from market chart prices
+	PlanIntervals []string `yaml:"plan_intervals"`
+}
+
+// GetHourlyTTL returns the TTL of short-range candles or default value
+func (c *OHLCFetcherConfig) GetHourlyTTL() time.Duration {
+	if c.HourlyTTL > 0 {
+		return c.HourlyTTL
+	}
+
+	return 30 * time.Minute
+}
+
+// GetDailyTTL returns the TTL of long-range candles or default value
+func (c *OHLCFetcherConfig) GetDailyTTL() time.Duration {
+	if c.DailyTTL > 0 {
+		return c.DailyTTL
+	}
+
+	return 12 * time.Hour
+}
+
+// HasPlanInterval checks if the upstream plan provides candles with the given interval
+func (c *OHLCFetcherConfig) HasPlanInterval(interval string) bool {
+	for _, planInterval := range c.PlanIntervals {
+		if planInterval == interval {
+			return true
+		}
+	}
+
+	return false
+}
@@ -20,8 +20,19 @@ type PricesFetcherConfig struct {
 	ChunkSize    int           `yaml:"chunk_size"`    // Number of tokens to fetch in one request
 	RequestDelay time.Duration `yaml:"request_delay"` // Delay between requests
 	Currencies   []string      `yaml:"currencies"`    // Default currencies to fetch
-	TTL          time.Duration `yaml:"ttl"`           // Time to live for cached price data
+	TTL          time.Duration `yaml:"ttl"`           // Time after which cached price data is served as stale
+	HardTTL      time.Duration `yaml:"hard_ttl"`      // Time after which cached price data is removed (defaults to TTL)
 	Tiers        []PriceTier   `yaml:"tiers"`         // Tier configurations
+
+	OnDemand OnDemandPricesConfig `yaml:"on_demand"` // Fetching of IDs missing in cache
+}
+
+// OnDemandPricesConfig defines fetching of prices which are missing in cache at request time
+type OnDemandPricesConfig struct {
+	Enabled           bool          `yaml:"enabled"`             // Whether missing IDs are fetched from upstream
+	MaxIdsPerRequest  int           `yaml:"max_ids_per_request"` // Maximum number of missing IDs fetched for one request
+	RequestsPerMinute int           `yaml:"requests_per_minute"` // Upstream requests budget dedicated to on-demand fetches
+	TTL               time.Duration `yaml:"ttl"`                 // Time to keep prices fetched on demand in cache
 }
 
 // Validate validates the PricesFetcherConfig configuration
@@ -83,3 +94,51 @@ func (c *PricesFetcherConfig) GetTTL() time.Duration {
 
 	return 30 * time.Second
 }
+
+// GetMinUpdateInterval returns the shortest tier update interval, TTL if no tiers are configured
+func (c *PricesFetcherConfig) GetMinUpdateInterval() time.Duration {
+	interval := c.GetTTL()
+	for _, tier := range c.Tiers {
+		if tier.UpdateInterval > 0 && tier.UpdateInterval < interval {
+			interval = tier.UpdateInterval
+		}
+	}
+	return interval
+}
+
+// GetHardTTL returns the hard TTL configuration, never less than TTL
+func (c *PricesFetcherConfig) GetHardTTL() time.Duration {
+	ttl := c.GetTTL()
+	if c.HardTTL > ttl {
+		return c.HardTTL
+	}
+
+	return ttl
+}
+
+// GetMaxIdsPerRequest returns the per request limit of on-demand fetched IDs or default value
+func (c *OnDemandPricesConfig) GetMaxIdsPerRequest() int {
+	if c.MaxIdsPerRequest > 0 {
+		return c.MaxIdsPerRequest
+	}
+
+	return 50
+}
+
+// GetRequestsPerMinute returns the on-demand upstream requests budget or default value
+func (c *OnDemandPricesConfig) GetRequestsPerMinute() int {
+	if c.RequestsPerMinute > 0 {
+		return c.RequestsPerMinute
+	}
+
+	return 30
+}
+
+// GetTTL returns the TTL of prices fetched on demand or default value
+func (c *OnDemandPricesConfig) GetTTL() time.Duration {
+	if c.TTL > 0 {
+		return c.TTL
+	}
+
+	return time.Minute
+}
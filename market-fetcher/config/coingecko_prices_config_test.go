@@ -174,3 +174,54 @@ func TestCoingeckoPricesFetcher_GetTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestCoingeckoPricesFetcher_GetHardTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   PricesFetcherConfig
+		expected time.Duration
+	}{
+		{
+			name: "with custom hard TTL",
+			config: PricesFetcherConfig{
+				TTL:     1 * time.Minute,
+				HardTTL: 1 * time.Hour,
+			},
+			expected: 1 * time.Hour,
+		},
+		{
+			name: "without hard TTL (falls back to TTL)",
+			config: PricesFetcherConfig{
+				TTL: 1 * time.Minute,
+			},
+			expected: 1 * time.Minute,
+		},
+		{
+			name: "with hard TTL less than TTL",
+			config: PricesFetcherConfig{
+				TTL:     10 * time.Minute,
+				HardTTL: 1 * time.Minute,
+			},
+			expected: 10 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.GetHardTTL()
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestOnDemandPricesConfig_Defaults(t *testing.T) {
+	cfg := OnDemandPricesConfig{}
+	assert.Equal(t, 50, cfg.GetMaxIdsPerRequest())
+	assert.Equal(t, 30, cfg.GetRequestsPerMinute())
+	assert.Equal(t, time.Minute, cfg.GetTTL())
+
+	cfg = OnDemandPricesConfig{MaxIdsPerRequest: 10, RequestsPerMinute: 5, TTL: 15 * time.Second}
+	assert.Equal(t, 10, cfg.GetMaxIdsPerRequest())
+	assert.Equal(t, 5, cfg.GetRequestsPerMinute())
+	assert.Equal(t, 15*time.Second, cfg.GetTTL())
+}
@@ -0,0 +1,17 @@
+package config
+
+import "time"
+
+// TrendingFetcherConfig represents configuration for CoinGecko trending service
+type TrendingFetcherConfig struct {
+	UpdateInterval time.Duration `yaml:"update_interval"` // Interval between /search/trending updates
+}
+
+// GetUpdateInterval returns the update interval or default value
+func (c *TrendingFetcherConfig) GetUpdateInterval() time.Duration {
+	if c.UpdateInterval > 0 {
+		return c.UpdateInterval
+	}
+
+	return 10 * time.Minute
+}
@@ -7,25 +7,45 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/status-im/market-proxy/alerts"
 	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/clientauth"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 type Config struct {
-	CoingeckoLeaderboard LeaderboardFetcherConfig `yaml:"coingecko_leaderboard"`
-	CoingeckoMarkets     MarketsFetcherConfig     `yaml:"coingecko_markets"`
-	CoingeckoPrices      PricesFetcherConfig      `yaml:"coingecko_prices"`
-	CoingeckoMarketChart MarketChartFetcherConfig `yaml:"coingecko_market_chart"`
-	CoingeckoCoins       FetcherByIdConfig        `yaml:"coingecko_coins"`
-	TokensFetcher        CoinslistFetcherConfig   `yaml:"coingecko_coinslist"`
-	TokenListFetcher     TokenListFetcherConfig   `yaml:"coingecko_token_list"`
-	TokensFile           string                   `yaml:"tokens_file"`
+	CoingeckoLeaderboard LeaderboardFetcherConfig     `yaml:"coingecko_leaderboard"`
+	CoingeckoMarkets     MarketsFetcherConfig         `yaml:"coingecko_markets"`
+	CoingeckoPrices      PricesFetcherConfig          `yaml:"coingecko_prices"`
+	CoingeckoMarketChart MarketChartFetcherConfig     `yaml:"coingecko_market_chart"`
+	CoingeckoOHLC        OHLCFetcherConfig            `yaml:"coingecko_ohlc"`
+	CoingeckoCoins       FetcherByIdConfig            `yaml:"coingecko_coins"`
+	CoingeckoRates       ExchangeRatesFetcherConfig   `yaml:"coingecko_exchange_rates"`
+	CoingeckoCategories  CategoriesFetcherConfig      `yaml:"coingecko_categories"`
+	CoingeckoGlobal      GlobalFetcherConfig          `yaml:"coingecko_global"`
+	CoingeckoTrending    TrendingFetcherConfig        `yaml:"coingecko_trending"`
+	CoingeckoPlatforms   AssetsPlatformsFetcherConfig `yaml:"coingecko_assets_platforms"`
+	TokensFetcher        CoinslistFetcherConfig       `yaml:"coingecko_coinslist"`
+	TokenListFetcher     TokenListFetcherConfig       `yaml:"coingecko_token_list"`
+	TokensFile           string                       `yaml:"tokens_file"`
 	APITokens            *APITokens
-	Cache                cache.Config `yaml:"cache"`
+	Cache                cache.Config       `yaml:"cache"`
+	Snapshot             snapshot.Config    `yaml:"snapshot"`
+	Alerts               alerts.Config      `yaml:"alerts"`
+	ClientAuth           clientauth.Config  `yaml:"client_auth"`
+	PriceHistory         PriceHistoryConfig `yaml:"price_history"`
 
 	OverrideCoingeckoPublicURL string `yaml:"override_coingecko_public_url"`
 	OverrideCoingeckoProURL    string `yaml:"override_coingecko_pro_url"`
 
 	APIKeySettings APIKeyConfig `yaml:"api_key_settings"`
+
+	ConfigReload ReloadConfig `yaml:"config_reload"`
+
+	Admin AdminConfig `yaml:"admin"`
+
+	// Path is the file the config was loaded from, used to reload it
+	Path string `yaml:"-"`
 }
 
 func LoadConfig(filename string) (*Config, error) {
@@ -42,7 +62,7 @@ func LoadConfig(filename string) (*Config, error) {
 
 	// Set default cache config if not provided
 	if config.Cache.GoCache.DefaultExpiration == 0 && config.Cache.GoCache.CleanupInterval == 0 {
-		config.Cache = cache.DefaultCacheConfig()
+		config.Cache.GoCache = cache.DefaultCacheConfig().GoCache
 	}
 
 	// Set default market chart config if not provided
@@ -59,10 +79,29 @@ func LoadConfig(filename string) (*Config, error) {
 		config.APITokens = apiTokens
 	}
 
-	// Validate coingecko markets configuration
-	if err := config.CoingeckoMarkets.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid coingecko_markets configuration: %w", err)
+	if err := config.ClientAuth.LoadConsumers(); err != nil {
+		return nil, fmt.Errorf("invalid client_auth configuration: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
 
+	config.Path = filename
 	return &config, nil
 }
+
+// Validate checks configuration sections which can't be used with invalid values
+func (c *Config) Validate() error {
+	// Validate coingecko markets configuration
+	if err := c.CoingeckoMarkets.Validate(); err != nil {
+		return fmt.Errorf("invalid coingecko_markets configuration: %w", err)
+	}
+
+	// Validate alerts configuration
+	if err := c.Alerts.Validate(); err != nil {
+		return fmt.Errorf("invalid alerts configuration: %w", err)
+	}
+
+	return nil
+}
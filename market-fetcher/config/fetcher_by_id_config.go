@@ -62,6 +62,26 @@ type FetcherByIdConfig struct {
 
 	// Tiers defines tier-based configuration for different token ranges (required)
 	Tiers []GenericTier `yaml:"tiers"`
+
+	// OnDemand enables fetching of IDs missing in cache at request time
+	OnDemand FetcherByIdOnDemandConfig `yaml:"on_demand"`
+}
+
+// FetcherByIdOnDemandConfig defines fetching of single IDs which are missing in cache at request time
+type FetcherByIdOnDemandConfig struct {
+	// Enabled determines whether missing IDs are fetched from upstream
+	Enabled bool `yaml:"enabled"`
+
+	// RequestsPerMinute is the upstream requests budget dedicated to on-demand fetches (default: 30)
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+}
+
+// GetRequestsPerMinute returns the on-demand requests budget with a default value
+func (c *FetcherByIdOnDemandConfig) GetRequestsPerMinute() int {
+	if c.RequestsPerMinute <= 0 {
+		return 30 // default budget
+	}
+	return c.RequestsPerMinute
 }
 
 // GetFetchMode determines the fetch mode based on the endpoint path template
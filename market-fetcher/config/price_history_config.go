@@ -0,0 +1,53 @@
+package config
+
+import "time"
+
+// PriceHistoryConfig represents configuration of the local price history recorded from prices updates
+type PriceHistoryConfig struct {
+	Enabled    bool                  `yaml:"enabled"`    // Whether prices updates are recorded and used to serve market charts
+	Currencies []string              `yaml:"currencies"` // Currencies to record (defaults to usd)
+	Retention  PriceHistoryRetention `yaml:"retention"`  // How long points are kept for each resolution
+}
+
+// PriceHistoryRetention defines how long points of each resolution are kept
+type PriceHistoryRetention struct {
+	Minute     time.Duration `yaml:"1m"` // Retention of 1 minute buckets
+	FiveMinute time.Duration `yaml:"5m"` // Retention of 5 minute buckets, limits days of charts with 5m interval
+	Hour       time.Duration `yaml:"1h"` // Retention of 1 hour buckets, limits days of hourly charts
+}
+
+// GetCurrencies returns the recorded currencies or default value
+func (c *PriceHistoryConfig) GetCurrencies() []string {
+	if len(c.Currencies) > 0 {
+		return c.Currencies
+	}
+
+	return []string{"usd"}
+}
+
+// GetMinute returns retention of 1 minute buckets or default value
+func (c *PriceHistoryRetention) GetMinute() time.Duration {
+	if c.Minute > 0 {
+		return c.Minute
+	}
+
+	return time.Hour
+}
+
+// GetFiveMinute returns retention of 5 minute buckets or default value
+func (c *PriceHistoryRetention) GetFiveMinute() time.Duration {
+	if c.FiveMinute > 0 {
+		return c.FiveMinute
+	}
+
+	return 24 * time.Hour
+}
+
+// GetHour returns retention of 1 hour buckets or default value
+func (c *PriceHistoryRetention) GetHour() time.Duration {
+	if c.Hour > 0 {
+		return c.Hour
+	}
+
+	return 7 * 24 * time.Hour
+}
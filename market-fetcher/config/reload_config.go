@@ -0,0 +1,54 @@
+package config
+
+import (
+	"reflect"
+	"time"
+)
+
+// ReloadConfig represents configuration of config.yaml and API tokens file hot reload
+type ReloadConfig struct {
+	Interval time.Duration `yaml:"interval"` // How often files are checked for changes, 0 disables (SIGHUP still reloads)
+}
+
+// restartSection is a config section which is applied only on start
+type restartSection struct {
+	name string
+	get  func(cfg *Config) interface{}
+}
+
+// restartSections are sections services read only on start, reloading them has no effect until restart.
+// Sections which are partially reloadable are compared without their reloadable fields.
+var restartSections = []restartSection{
+	{"coingecko_prices.on_demand", func(c *Config) interface{} { return c.CoingeckoPrices.OnDemand }},
+	{"coingecko_market_chart", func(c *Config) interface{} { return c.CoingeckoMarketChart }},
+	{"coingecko_ohlc", func(c *Config) interface{} { return c.CoingeckoOHLC }},
+	{"coingecko_coins", func(c *Config) interface{} {
+		coins := c.CoingeckoCoins
+		coins.Tiers, coins.TTL = nil, 0
+		return coins
+	}},
+	{"coingecko_assets_platforms.chain_ids", func(c *Config) interface{} { return c.CoingeckoPlatforms.ChainIDs }},
+	// Periodic updates can't be enabled or disabled live, other interval changes are applied
+	{"coingecko_coinslist.update_interval", func(c *Config) interface{} { return c.TokensFetcher.UpdateInterval > 0 }},
+	{"coingecko_token_list.update_interval", func(c *Config) interface{} { return c.TokenListFetcher.UpdateInterval > 0 }},
+	{"cache", func(c *Config) interface{} { return c.Cache }},
+	{"snapshot", func(c *Config) interface{} { return c.Snapshot }},
+	{"alerts", func(c *Config) interface{} { return c.Alerts }},
+	{"price_history", func(c *Config) interface{} { return c.PriceHistory }},
+	{"override_coingecko_public_url", func(c *Config) interface{} { return c.OverrideCoingeckoPublicURL }},
+	{"override_coingecko_pro_url", func(c *Config) interface{} { return c.OverrideCoingeckoProURL }},
+	{"config_reload", func(c *Config) interface{} { return c.ConfigReload }},
+	{"admin", func(c *Config) interface{} { return c.Admin }},
+}
+
+// RestartRequired returns names of sections which differ in reloaded config from the config
+// services were started with and are not applied until restart
+func RestartRequired(startup, reloaded *Config) []string {
+	var sections []string
+	for _, section := range restartSections {
+		if !reflect.DeepEqual(section.get(startup), section.get(reloaded)) {
+			sections = append(sections, section.name)
+		}
+	}
+	return sections
+}
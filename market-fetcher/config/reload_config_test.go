@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartRequired(t *testing.T) {
+	startup := &Config{
+		CoingeckoCoins: FetcherByIdConfig{Name: "coins", ChunkSize: 100, TTL: time.Hour},
+		TokensFetcher:  CoinslistFetcherConfig{UpdateInterval: 30 * time.Minute},
+	}
+
+	t.Run("reloadable fields are not reported", func(t *testing.T) {
+		reloaded := &Config{
+			CoingeckoCoins: FetcherByIdConfig{
+				Name:      "coins",
+				ChunkSize: 100,
+				TTL:       2 * time.Hour,
+				Tiers:     []GenericTier{{Name: "top", IdFrom: 1, IdTo: 100, UpdateInterval: time.Minute}},
+			},
+			TokensFetcher:        CoinslistFetcherConfig{UpdateInterval: time.Hour, SupportedPlatforms: []string{"ethereum"}},
+			CoingeckoLeaderboard: LeaderboardFetcherConfig{TopMarketsLimit: 100, Currency: "eur"},
+		}
+		assert.Empty(t, RestartRequired(startup, reloaded))
+	})
+
+	t.Run("restart-only fields are reported", func(t *testing.T) {
+		reloaded := &Config{
+			CoingeckoCoins: FetcherByIdConfig{Name: "coins", ChunkSize: 50, TTL: time.Hour},
+			TokensFetcher:  CoinslistFetcherConfig{UpdateInterval: 0},
+		}
+		assert.Equal(t, []string{"coingecko_coins", "coingecko_coinslist.update_interval"}, RestartRequired(startup, reloaded))
+	})
+}
@@ -0,0 +1,259 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/scheduler"
+)
+
+// Version describes the active config
+type Version struct {
+	Version   int       `json:"version"` // 1 for the initial config, incremented on every applied reload
+	Hash      string    `json:"hash"`    // SHA-256 of config and API tokens files
+	LoadedAt  time.Time `json:"loaded_at"`
+	LastError string    `json:"last_error,omitempty"` // Reason the last reload was rejected
+
+	// RestartRequired lists changed sections which are applied only on start
+	RestartRequired []string `json:"restart_required,omitempty"`
+}
+
+// ApplyFunc applies a validated reloaded config
+type ApplyFunc func(cfg *Config)
+
+// Watcher reloads config, API tokens and consumers files when they change or on SIGHUP.
+// Invalid configs are rejected and the active config is kept.
+type Watcher struct {
+	path      string
+	interval  time.Duration
+	apply     ApplyFunc
+	startup   *Config // config services were started with, restart-only sections are compared to it
+	scheduler *scheduler.Scheduler
+	signals   chan os.Signal
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+
+	// reloadMu serializes reloads triggered by file changes and signals
+	reloadMu      sync.Mutex
+	tokensFile    string
+	consumersFile string
+	rejectedHash  string // hash of files content rejected by the last reload
+
+	version struct {
+		sync.RWMutex
+		current Version
+	}
+}
+
+// NewWatcher creates a watcher of the files cfg was loaded from
+func NewWatcher(cfg *Config, apply ApplyFunc) *Watcher {
+	w := &Watcher{
+		path:          cfg.Path,
+		interval:      cfg.ConfigReload.Interval,
+		apply:         apply,
+		startup:       cfg,
+		tokensFile:    cfg.TokensFile,
+		consumersFile: cfg.ClientAuth.ConsumersFile,
+	}
+
+	w.version.current = Version{Version: 1, LoadedAt: time.Now()}
+	if w.path != "" {
+		hash, err := w.filesHash()
+		if err != nil {
+			log.Printf("Config watcher: failed to hash config files: %v", err)
+		}
+		w.version.current.Hash = hash
+	}
+
+	return w
+}
+
+// Start implements core.Interface
+func (w *Watcher) Start(ctx context.Context) error {
+	if w.path == "" {
+		log.Printf("Config watcher: config path is unknown, hot reload disabled")
+		return nil
+	}
+
+	metrics.ConfigVersionGauge.Set(1)
+
+	ctx, w.cancel = context.WithCancel(ctx)
+
+	w.signals = make(chan os.Signal, 1)
+	signal.Notify(w.signals, syscall.SIGHUP)
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		for {
+			select {
+			case <-w.signals:
+				log.Printf("Config watcher: received SIGHUP, reloading %s", w.path)
+				_ = w.Reload()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	if w.interval > 0 {
+		w.scheduler = scheduler.New(w.interval, func(ctx context.Context) {
+			w.checkFiles()
+		})
+		w.scheduler.Start(ctx, false)
+	}
+
+	return nil
+}
+
+// Stop implements core.Interface
+func (w *Watcher) Stop() {
+	if w.scheduler != nil {
+		w.scheduler.Stop()
+	}
+	if w.signals != nil {
+		signal.Stop(w.signals)
+	}
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+}
+
+// Version returns the active config version
+func (w *Watcher) Version() Version {
+	w.version.RLock()
+	defer w.version.RUnlock()
+	return w.version.current
+}
+
+// checkFiles reloads config if the config or API tokens file has changed,
+// content which has already been rejected is not reloaded again
+func (w *Watcher) checkFiles() {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	hash, err := w.filesHash()
+	if err != nil {
+		log.Printf("Config watcher: failed to hash config files: %v", err)
+		return
+	}
+
+	if hash == w.Version().Hash || hash == w.rejectedHash {
+		return
+	}
+
+	log.Printf("Config watcher: config files of %s changed, reloading", w.path)
+	_ = w.reloadLocked(hash)
+}
+
+// Reload loads and validates config files and applies them if they are valid and changed
+func (w *Watcher) Reload() error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	hash, err := w.filesHash()
+	if err != nil {
+		log.Printf("Config watcher: failed to hash config files: %v", err)
+	} else if hash == w.Version().Hash {
+		log.Printf("Config watcher: config is unchanged")
+		return nil
+	}
+
+	return w.reloadLocked(hash)
+}
+
+// reloadLocked loads, validates and applies config files with the given hash, reloadMu must be held
+func (w *Watcher) reloadLocked(hash string) error {
+	cfg, err := w.load()
+	if err != nil {
+		w.rejectedHash = hash
+
+		w.version.Lock()
+		w.version.current.LastError = err.Error()
+		version := w.version.current.Version
+		w.version.Unlock()
+
+		metrics.RecordConfigReload("rejected", version)
+		log.Printf("Config watcher: rejected reload, keeping config version %d: %v", version, err)
+		return err
+	}
+
+	w.apply(cfg)
+	w.tokensFile = cfg.TokensFile
+	w.consumersFile = cfg.ClientAuth.ConsumersFile
+	w.rejectedHash = ""
+
+	// Hash files again, the tokens and consumers files may have changed
+	if newHash, err := w.filesHash(); err == nil {
+		hash = newHash
+	}
+
+	restartRequired := RestartRequired(w.startup, cfg)
+
+	w.version.Lock()
+	w.version.current = Version{
+		Version:         w.version.current.Version + 1,
+		Hash:            hash,
+		LoadedAt:        time.Now(),
+		RestartRequired: restartRequired,
+	}
+	version := w.version.current.Version
+	w.version.Unlock()
+
+	metrics.RecordConfigReload("applied", version)
+	if len(restartRequired) > 0 {
+		log.Printf("Config watcher: applied config version %d, changes of %s require restart",
+			version, strings.Join(restartRequired, ", "))
+	} else {
+		log.Printf("Config watcher: applied config version %d", version)
+	}
+	return nil
+}
+
+// load loads config and checks sections which are validated only on services start
+func (w *Watcher) load() (*Config, error) {
+	cfg, err := LoadConfig(w.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.CoingeckoPrices.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid coingecko_prices configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// filesHash returns SHA-256 of config, API tokens and consumers files, missing optional files are hashed as empty
+func (w *Watcher) filesHash() (string, error) {
+	configData, err := os.ReadFile(w.path)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write(configData)
+
+	for _, path := range []string{w.tokensFile, w.consumersFile} {
+		data, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		hash.Write([]byte{0})
+		hash.Write(data)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
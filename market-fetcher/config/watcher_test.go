@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watcherTestConfig = `
+tokens_file: "%s"
+coingecko_markets:
+  tiers:
+    - name: "top"
+      page_from: 1
+      page_to: 10
+      update_interval: %s
+coingecko_prices:
+  tiers:
+    - name: "top"
+      token_from: 1
+      token_to: 100
+      update_interval: 30s
+`
+
+func writeWatcherTestFiles(t *testing.T, dir, interval, tokens string) string {
+	tokensPath := filepath.Join(dir, "tokens.json")
+	require.NoError(t, os.WriteFile(tokensPath, []byte(tokens), 0644))
+
+	configPath := filepath.Join(dir, "config.yaml")
+	configYAML := []byte(fmt.Sprintf(watcherTestConfig, tokensPath, interval))
+	require.NoError(t, os.WriteFile(configPath, configYAML, 0644))
+	return configPath
+}
+
+func newTestWatcher(t *testing.T) (*Watcher, string, *[]*Config) {
+	dir := t.TempDir()
+	configPath := writeWatcherTestFiles(t, dir, "1m", `{"api_tokens": ["key-1"]}`)
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+
+	applied := &[]*Config{}
+	w := NewWatcher(cfg, func(cfg *Config) {
+		*applied = append(*applied, cfg)
+	})
+	return w, dir, applied
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	t.Run("unchanged files are not applied", func(t *testing.T) {
+		w, _, applied := newTestWatcher(t)
+
+		require.NoError(t, w.Reload())
+		assert.Empty(t, *applied)
+		assert.Equal(t, 1, w.Version().Version)
+	})
+
+	t.Run("changed config is applied", func(t *testing.T) {
+		w, dir, applied := newTestWatcher(t)
+		initialHash := w.Version().Hash
+
+		writeWatcherTestFiles(t, dir, "2m", `{"api_tokens": ["key-1"]}`)
+		require.NoError(t, w.Reload())
+
+		require.Len(t, *applied, 1)
+		assert.Equal(t, "2m0s", (*applied)[0].CoingeckoMarkets.Tiers[0].UpdateInterval.String())
+		assert.Equal(t, 2, w.Version().Version)
+		assert.NotEqual(t, initialHash, w.Version().Hash)
+		assert.Empty(t, w.Version().LastError)
+		assert.Empty(t, w.Version().RestartRequired)
+	})
+
+	t.Run("changed restart-only sections are reported", func(t *testing.T) {
+		w, dir, applied := newTestWatcher(t)
+
+		configPath := writeWatcherTestFiles(t, dir, "1m", `{"api_tokens": ["key-1"]}`)
+		configFile, err := os.OpenFile(configPath, os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		_, err = configFile.WriteString("coingecko_ohlc:\n  hourly_ttl: 5m\ncache:\n  go_cache:\n    default_expiration: 1m\n")
+		require.NoError(t, err)
+		require.NoError(t, configFile.Close())
+
+		require.NoError(t, w.Reload())
+		require.Len(t, *applied, 1)
+		assert.Equal(t, []string{"coingecko_ohlc", "cache"}, w.Version().RestartRequired)
+	})
+
+	t.Run("changed tokens file is applied", func(t *testing.T) {
+		w, dir, applied := newTestWatcher(t)
+
+		writeWatcherTestFiles(t, dir, "1m", `{"api_tokens": ["key-2"]}`)
+		w.checkFiles()
+
+		require.Len(t, *applied, 1)
+		tokens, _ := (*applied)[0].APITokens.Keys()
+		assert.Equal(t, []string{"key-2"}, tokens)
+		assert.Equal(t, 2, w.Version().Version)
+	})
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		w, dir, applied := newTestWatcher(t)
+
+		writeWatcherTestFiles(t, dir, "-1m", `{"api_tokens": ["key-1"]}`)
+		assert.Error(t, w.Reload())
+
+		assert.Empty(t, *applied)
+		assert.Equal(t, 1, w.Version().Version)
+		assert.NotEmpty(t, w.Version().LastError)
+
+		// Rejected content is not reloaded again by polling
+		w.checkFiles()
+		assert.Empty(t, *applied)
+
+		// Fixed config is applied and clears the error
+		writeWatcherTestFiles(t, dir, "3m", `{"api_tokens": ["key-1"]}`)
+		w.checkFiles()
+		require.Len(t, *applied, 1)
+		assert.Equal(t, 2, w.Version().Version)
+		assert.Empty(t, w.Version().LastError)
+	})
+}
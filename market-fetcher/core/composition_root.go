@@ -4,18 +4,28 @@ import (
 	"context"
 	"os"
 
+	"github.com/status-im/market-proxy/alerts"
 	"github.com/status-im/market-proxy/api"
 	"github.com/status-im/market-proxy/cache"
+	"github.com/status-im/market-proxy/clientauth"
 	"github.com/status-im/market-proxy/coingecko_assets_platforms"
+	"github.com/status-im/market-proxy/coingecko_categories"
 	"github.com/status-im/market-proxy/coingecko_coins"
 	cg "github.com/status-im/market-proxy/coingecko_common"
+	"github.com/status-im/market-proxy/coingecko_exchange_rates"
+	"github.com/status-im/market-proxy/coingecko_global"
 	"github.com/status-im/market-proxy/coingecko_leaderboard"
 	"github.com/status-im/market-proxy/coingecko_market_chart"
 	"github.com/status-im/market-proxy/coingecko_markets"
+	"github.com/status-im/market-proxy/coingecko_ohlc"
 	"github.com/status-im/market-proxy/coingecko_prices"
 	"github.com/status-im/market-proxy/coingecko_token_list"
 	"github.com/status-im/market-proxy/coingecko_tokens"
+	"github.com/status-im/market-proxy/coingecko_trending"
 	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/price_history"
+	"github.com/status-im/market-proxy/search"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
 // Setup creates and registers all services
@@ -29,34 +39,88 @@ func Setup(ctx context.Context, cfg *config.Config) (*Registry, error) {
 	cacheService := cache.NewService(cfg.Cache)
 	registry.Register(cacheService)
 
+	// Warm-start snapshots store (nil if disabled)
+	snapshotStore := snapshot.NewStore(cfg.Snapshot)
+
 	// Tokens service
 	tokensService := coingecko_tokens.NewService(cfg)
+	tokensService.SetSnapshotStore(snapshotStore)
 	registry.Register(tokensService)
 
 	// Token List service
 	tokenListService := coingecko_token_list.NewService(cfg)
 	registry.Register(tokenListService)
 
+	// Exchange rates service, used to derive prices in currencies which are not cached
+	exchangeRatesService := coingecko_exchange_rates.NewService(cfg)
+	registry.Register(exchangeRatesService)
+
+	// Categories service, list of categories accepted by /coins/markets
+	categoriesService := coingecko_categories.NewService(cfg)
+	registry.Register(categoriesService)
+
 	// Markets service
 	marketsService := coingecko_markets.NewService(cacheService, cfg, tokensService)
+	marketsService.SetSnapshotStore(snapshotStore)
+	marketsService.SetCurrencyConverter(exchangeRatesService)
 	registry.Register(marketsService)
 
 	// Coins service
 	coinsService := coingecko_coins.NewService(cfg, marketsService, cacheService)
+	coinsService.SetSnapshotStore(snapshotStore)
+	coinsService.SetTokensService(tokensService)
 	registry.Register(coinsService)
 
+	// Search service, indexes coins list ranked by market cap
+	searchService := search.NewService(tokensService, marketsService)
+	registry.Register(searchService)
+
+	// Price history service, records prices updates to serve short-range market charts locally.
+	// Registered before prices service so that history is restored before prices are recorded.
+	var priceHistoryService *price_history.Service
+	if cfg.PriceHistory.Enabled {
+		priceHistoryService = price_history.NewService(&cfg.PriceHistory)
+		priceHistoryService.SetSnapshotStore(snapshotStore)
+		registry.Register(priceHistoryService)
+	}
+
 	// Prices service
 	pricesService := coingecko_prices.NewService(cacheService, cfg, marketsService, tokensService)
+	pricesService.SetSnapshotStore(snapshotStore)
+	pricesService.SetCurrencyConverter(exchangeRatesService)
+	if priceHistoryService != nil {
+		pricesService.SetPriceHistory(priceHistoryService)
+	}
 	registry.Register(pricesService)
 
+	// Price alerts service
+	alertsService := alerts.NewService(cfg.Alerts, pricesService)
+	registry.Register(alertsService)
+
 	// MarketChart service
 	marketChartService := coingecko_market_chart.NewService(cacheService, cfg)
+	if priceHistoryService != nil {
+		marketChartService.SetPriceHistory(priceHistoryService)
+	}
 	registry.Register(marketChartService)
 
+	// OHLC service, candles missing in the upstream plan are built from market chart prices
+	ohlcService := coingecko_ohlc.NewService(cacheService, cfg)
+	ohlcService.SetMarketChartProvider(marketChartService)
+	registry.Register(ohlcService)
+
 	// Assets Platforms service
 	assetsPlatformsService := coingecko_assets_platforms.NewService(cfg)
 	registry.Register(assetsPlatformsService)
 
+	// Global market stats service
+	globalService := coingecko_global.NewService(cfg)
+	registry.Register(globalService)
+
+	// Trending service
+	trendingService := coingecko_trending.NewService(cfg)
+	registry.Register(trendingService)
+
 	// Leaderboard service
 	cgService := coingecko_leaderboard.NewService(cfg, pricesService, marketsService)
 	registry.Register(cgService)
@@ -65,10 +129,53 @@ func Setup(ctx context.Context, cfg *config.Config) (*Registry, error) {
 	if port == "" {
 		port = "8080"
 	}
+	if err := cfg.Admin.Validate(port); err != nil {
+		return nil, err
+	}
 
 	// HTTP Server
-	server := api.New(port, cgService, tokensService, pricesService, marketsService, marketChartService, assetsPlatformsService, tokenListService, coinsService)
+	server := api.New(port, api.Dependencies{
+		Leaderboard:     cgService,
+		Tokens:          tokensService,
+		Prices:          pricesService,
+		Markets:         marketsService,
+		MarketChart:     marketChartService,
+		OHLC:            ohlcService,
+		AssetsPlatforms: assetsPlatformsService,
+		TokenList:       tokenListService,
+		Coins:           coinsService,
+		Search:          searchService,
+		Categories:      categoriesService,
+		Global:          globalService,
+		Trending:        trendingService,
+	})
+	server.ApplyConfig(cfg)
 	registry.Register(server)
 
+	// Client authentication and per-consumer quotas of API requests
+	clientAuthService := clientauth.NewService(cfg.ClientAuth)
+	server.SetClientAuth(clientAuthService)
+	registry.Register(clientAuthService)
+
+	// Admin server on a separate port: tiers status, forced refreshes, cache and API keys inspection
+	adminServer := api.NewAdminServer(cfg.Admin, cacheService)
+	adminServer.RegisterTiersService("coingecko_prices", pricesService)
+	adminServer.RegisterTiersService("coingecko_markets", marketsService)
+	adminServer.RegisterTiersService("coingecko_coins", coinsService)
+	adminServer.SetAlertsService(alertsService)
+	registry.Register(adminServer)
+
+	// Config watcher, reloads config and API tokens files on change or SIGHUP
+	configWatcher := config.NewWatcher(cfg, func(newCfg *config.Config) {
+		// Key managers keep the initial tokens pointer, so tokens are replaced in place
+		cfg.APITokens.Replace(newCfg.APITokens)
+		newCfg.APITokens = cfg.APITokens
+		cg.GetRateLimiterManagerInstance().SetConfig(newCfg.APIKeySettings)
+		clientAuthService.SetConfig(newCfg.ClientAuth)
+		registry.ApplyConfig(newCfg)
+	})
+	server.SetConfigWatcher(configWatcher)
+	registry.Register(configWatcher)
+
 	return registry, nil
 }
@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+
+	"github.com/status-im/market-proxy/config"
 )
 
 // IService defines a common interface for all services
@@ -10,6 +12,11 @@ type IService interface {
 	Stop()
 }
 
+// IReloadable is implemented by services which apply reloaded config without restart
+type IReloadable interface {
+	ApplyConfig(cfg *config.Config)
+}
+
 // Registry manages all services
 type Registry struct {
 	services []IService
@@ -44,3 +51,12 @@ func (sr *Registry) StopAll() {
 		sr.services[i].Stop()
 	}
 }
+
+// ApplyConfig passes reloaded config to all reloadable services in registration order
+func (sr *Registry) ApplyConfig(cfg *config.Config) {
+	for _, service := range sr.services {
+		if reloadable, ok := service.(IReloadable); ok {
+			reloadable.ApplyConfig(cfg)
+		}
+	}
+}
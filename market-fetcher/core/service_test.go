@@ -5,6 +5,8 @@ import (
 	"errors"
 	"sync"
 	"testing"
+
+	"github.com/status-im/market-proxy/config"
 )
 
 // MockService implements the IService for testing
@@ -266,3 +268,27 @@ func (s *recordingService) Start(ctx context.Context) error {
 func (s *recordingService) Stop() {
 	s.recorder.RecordStop(s.id)
 }
+
+// reloadableService records applied configs
+type reloadableService struct {
+	MockService
+	applied []*config.Config
+}
+
+func (s *reloadableService) ApplyConfig(cfg *config.Config) {
+	s.applied = append(s.applied, cfg)
+}
+
+func TestRegistry_ApplyConfig(t *testing.T) {
+	registry := NewRegistry()
+	reloadable := &reloadableService{}
+	registry.Register(NewMockService())
+	registry.Register(reloadable)
+
+	cfg := &config.Config{}
+	registry.ApplyConfig(cfg)
+
+	if len(reloadable.applied) != 1 || reloadable.applied[0] != cfg {
+		t.Errorf("Expected config to be applied once to reloadable service, got %v", reloadable.applied)
+	}
+}
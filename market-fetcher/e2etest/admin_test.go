@@ -0,0 +1,61 @@
+package e2etest
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/clientauth"
+	"github.com/status-im/market-proxy/config"
+)
+
+// doRequest sends a request with optional bearer token and returns the response status
+func doRequest(t *testing.T, method, url, token, body string) int {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(request)
+	require.NoError(t, err, "Should be able to make a request to %s", url)
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+// TestAdminAlertRulesWithClientAuth checks that alert rules are managed on the admin listener
+// with the admin token while client authentication guards the public API
+func TestAdminAlertRulesWithClientAuth(t *testing.T) {
+	adminPort := fmt.Sprintf("%d", 9100+rand.Intn(1000)) // Random port between 9100-10100
+	env := SetupTestWithConfig(t, func(cfg *config.Config) {
+		cfg.ClientAuth = clientauth.Config{
+			Enabled:   true,
+			Consumers: []clientauth.Consumer{{Name: "wallet", Keys: []string{"client-key"}}},
+		}
+		cfg.Admin = config.AdminConfig{Port: adminPort, Token: "admin-secret"}
+	})
+	defer env.TearDown()
+
+	adminURL := fmt.Sprintf("http://127.0.0.1:%s/api/v1/admin/alerts/rules", adminPort)
+	rule := `{"name":"btc-above","token_id":"bitcoin","type":"above","threshold":100000}`
+
+	// Admin listener accepts the admin token and is not guarded by client authentication
+	assert.Equal(t, http.StatusUnauthorized, doRequest(t, "GET", adminURL, "client-key", ""))
+	assert.Equal(t, http.StatusOK, doRequest(t, "POST", adminURL, "admin-secret", rule))
+	assert.Equal(t, http.StatusOK, doRequest(t, "GET", adminURL, "admin-secret", ""))
+	assert.Equal(t, http.StatusNoContent, doRequest(t, "DELETE", adminURL+"/btc-above", "admin-secret", ""))
+
+	// Public listener requires a consumer key and does not serve admin routes
+	assert.Equal(t, http.StatusUnauthorized, doRequest(t, "GET", env.ServerBaseURL+"/api/v1/coins/markets?ids=bitcoin", "admin-secret", ""))
+	assert.Eventually(t, func() bool {
+		return doRequest(t, "GET", env.ServerBaseURL+"/api/v1/coins/markets?ids=bitcoin", "client-key", "") == http.StatusOK
+	}, 5*time.Second, 100*time.Millisecond, "Consumer key should be accepted once markets are loaded")
+	assert.Equal(t, http.StatusNotFound, doRequest(t, "GET", env.ServerBaseURL+"/api/v1/admin/alerts/rules", "admin-secret", ""))
+}
@@ -95,6 +95,12 @@ coingecko_coins:
       id_to: 10
       update_interval: 1s
 
+# requests to the mock server are not throttled
+api_key_settings:
+  pro:
+    rate_limit_per_minute: 60000
+    burst: 1000
+
 tokens_file: "%s"           # path to tokens file will be inserted
 
 # URLs for API (mock)
@@ -95,14 +95,14 @@ func TestCoinsMarketsEndpoint(t *testing.T) {
 		require.Equal(t, http.StatusOK, resp.StatusCode, "Should return status 200 OK")
 	})
 
-	// Test with category parameter
+	// Test with category parameter, categories without a configured tier are rejected
 	t.Run("With Category Parameter", func(t *testing.T) {
 		testURL := env.ServerBaseURL + "/api/v1/coins/markets?category=layer-1"
 		resp, err := http.Get(testURL)
 		require.NoError(t, err, "Should be able to make a request with category parameter")
 		defer resp.Body.Close()
 
-		require.Equal(t, http.StatusOK, resp.StatusCode, "Should return status 200 OK")
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, "Should return status 422 for a category without a tier")
 	})
 
 	// Test with sparkline parameter
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/core"
 )
 
@@ -24,6 +25,11 @@ type TestEnv struct {
 
 // SetupTest sets up the test environment
 func SetupTest(t *testing.T) *TestEnv {
+	return SetupTestWithConfig(t, nil)
+}
+
+// SetupTestWithConfig sets up the test environment, configure adjusts the loaded configuration
+func SetupTestWithConfig(t *testing.T, configure func(cfg *config.Config)) *TestEnv {
 	// Create a context with cancellation capability
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -37,6 +43,9 @@ func SetupTest(t *testing.T) *TestEnv {
 		cancel()
 		t.Fatalf("Failed to load test config: %v", err)
 	}
+	if configure != nil {
+		configure(cfg)
+	}
 
 	// Use a random port for testing to avoid conflicts
 	testPort := fmt.Sprintf("%d", 8080+rand.Intn(1000)) // Random port between 8080-9080
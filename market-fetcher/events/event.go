@@ -0,0 +1,37 @@
+package events
+
+// Event describes a change published to subscribers
+// IDs are shared between subscribers and must not be modified
+type Event struct {
+	// Version is incremented by the manager on every published event
+	Version uint64
+	// Tier is a name of the updated tier, empty if the change is not tier specific
+	Tier string
+	// IDs are the changed IDs, empty if everything may have changed
+	IDs []string
+	// Restored is true if the data was restored from a snapshot and may be outdated
+	Restored bool
+}
+
+// IsFull reports whether the event does not narrow the change down to specific IDs
+func (e Event) IsFull() bool {
+	return len(e.IDs) == 0
+}
+
+// ChangedIDs merges IDs of events, full is true if any of the events is full
+func ChangedIDs(events []Event) (ids []string, full bool) {
+	seen := make(map[string]struct{})
+	for _, event := range events {
+		if event.IsFull() {
+			return nil, true
+		}
+		for _, id := range event.IDs {
+			if _, exists := seen[id]; exists {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	return ids, false
+}
@@ -21,6 +21,7 @@ import (
 type MockISubscription struct {
 	ctrl     *gomock.Controller
 	recorder *MockISubscriptionMockRecorder
+	isgomock struct{}
 }
 
 // MockISubscriptionMockRecorder is the mock recorder for MockISubscription.
@@ -66,24 +67,53 @@ func (mr *MockISubscriptionMockRecorder) Chan() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chan", reflect.TypeOf((*MockISubscription)(nil).Chan))
 }
 
+// Events mocks base method.
+func (m *MockISubscription) Events() []events.Event {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].([]events.Event)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockISubscriptionMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockISubscription)(nil).Events))
+}
+
 // Watch mocks base method.
-func (m *MockISubscription) Watch(arg0 context.Context, arg1 func(), arg2 bool) events.ISubscription {
+func (m *MockISubscription) Watch(parentCtx context.Context, cb func(), callNow bool) events.ISubscription {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Watch", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Watch", parentCtx, cb, callNow)
 	ret0, _ := ret[0].(events.ISubscription)
 	return ret0
 }
 
 // Watch indicates an expected call of Watch.
-func (mr *MockISubscriptionMockRecorder) Watch(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockISubscriptionMockRecorder) Watch(parentCtx, cb, callNow any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockISubscription)(nil).Watch), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockISubscription)(nil).Watch), parentCtx, cb, callNow)
+}
+
+// WatchEvents mocks base method.
+func (m *MockISubscription) WatchEvents(parentCtx context.Context, cb func([]events.Event), callNow bool) events.ISubscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvents", parentCtx, cb, callNow)
+	ret0, _ := ret[0].(events.ISubscription)
+	return ret0
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockISubscriptionMockRecorder) WatchEvents(parentCtx, cb, callNow any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockISubscription)(nil).WatchEvents), parentCtx, cb, callNow)
 }
 
 // MockISubscriptionManager is a mock of ISubscriptionManager interface.
 type MockISubscriptionManager struct {
 	ctrl     *gomock.Controller
 	recorder *MockISubscriptionManagerMockRecorder
+	isgomock struct{}
 }
 
 // MockISubscriptionManagerMockRecorder is the mock recorder for MockISubscriptionManager.
@@ -104,15 +134,27 @@ func (m *MockISubscriptionManager) EXPECT() *MockISubscriptionManagerMockRecorde
 }
 
 // Emit mocks base method.
-func (m *MockISubscriptionManager) Emit(arg0 context.Context) {
+func (m *MockISubscriptionManager) Emit(ctx context.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Emit", arg0)
+	m.ctrl.Call(m, "Emit", ctx)
 }
 
 // Emit indicates an expected call of Emit.
-func (mr *MockISubscriptionManagerMockRecorder) Emit(arg0 any) *gomock.Call {
+func (mr *MockISubscriptionManagerMockRecorder) Emit(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockISubscriptionManager)(nil).Emit), ctx)
+}
+
+// Publish mocks base method.
+func (m *MockISubscriptionManager) Publish(ctx context.Context, event events.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", ctx, event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockISubscriptionManagerMockRecorder) Publish(ctx, event any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockISubscriptionManager)(nil).Emit), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockISubscriptionManager)(nil).Publish), ctx, event)
 }
 
 // Subscribe mocks base method.
@@ -130,13 +172,13 @@ func (mr *MockISubscriptionManagerMockRecorder) Subscribe() *gomock.Call {
 }
 
 // Unsubscribe mocks base method.
-func (m *MockISubscriptionManager) Unsubscribe(arg0 chan struct{}) {
+func (m *MockISubscriptionManager) Unsubscribe(ch chan struct{}) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Unsubscribe", arg0)
+	m.ctrl.Call(m, "Unsubscribe", ch)
 }
 
 // Unsubscribe indicates an expected call of Unsubscribe.
-func (mr *MockISubscriptionManagerMockRecorder) Unsubscribe(arg0 any) *gomock.Call {
+func (mr *MockISubscriptionManagerMockRecorder) Unsubscribe(ch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockISubscriptionManager)(nil).Unsubscribe), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockISubscriptionManager)(nil).Unsubscribe), ch)
 }
@@ -98,3 +98,78 @@ func TestSubscriptionManager_MultipleEmitsCollapse(t *testing.T) {
 	require.Equalf(t, 1, received, "Expected 1 notifications, but received %d", received)
 	mu.Unlock()
 }
+
+func TestSubscriptionManager_PublishEvents(t *testing.T) {
+	sm := NewSubscriptionManager()
+	ctx := context.Background()
+
+	sub := sm.Subscribe()
+	defer sub.Cancel()
+
+	sm.Publish(ctx, Event{Tier: "top-1000", IDs: []string{"bitcoin", "ethereum"}})
+	sm.Publish(ctx, Event{IDs: []string{"solana", "bitcoin"}})
+
+	// Notifications collapse, events are kept
+	<-sub.Chan()
+	received := sub.Events()
+	require.Len(t, received, 2)
+	require.Equal(t, uint64(1), received[0].Version)
+	require.Equal(t, "top-1000", received[0].Tier)
+	require.Equal(t, uint64(2), received[1].Version)
+	require.Equal(t, uint64(2), sm.Version())
+
+	ids, full := ChangedIDs(received)
+	require.False(t, full)
+	require.Equal(t, []string{"bitcoin", "ethereum", "solana"}, ids)
+
+	// Events are cleared after reading
+	require.Empty(t, sub.Events())
+
+	// Emit publishes a full event
+	sm.Emit(ctx)
+	<-sub.Chan()
+	received = sub.Events()
+	require.Len(t, received, 1)
+	require.True(t, received[0].IsFull())
+	_, full = ChangedIDs(received)
+	require.True(t, full)
+}
+
+func TestSubscriptionManager_PendingEventsCollapse(t *testing.T) {
+	sm := NewSubscriptionManager()
+	ctx := context.Background()
+
+	sub := sm.Subscribe()
+	defer sub.Cancel()
+
+	for i := 0; i < maxPendingEvents+1; i++ {
+		sm.Publish(ctx, Event{IDs: []string{"bitcoin"}})
+	}
+
+	received := sub.Events()
+	require.Len(t, received, 1)
+	require.True(t, received[0].IsFull())
+	require.Equal(t, uint64(maxPendingEvents+1), received[0].Version)
+}
+
+func TestSubscription_WatchEvents(t *testing.T) {
+	sm := NewSubscriptionManager()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan []Event, 1)
+	sm.Subscribe().WatchEvents(ctx, func(events []Event) {
+		received <- events
+	}, false)
+
+	sm.Publish(ctx, Event{Tier: "top-1000", IDs: []string{"bitcoin"}})
+
+	select {
+	case events := <-received:
+		require.Len(t, events, 1)
+		require.Equal(t, []string{"bitcoin"}, events[0].IDs)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for events")
+	}
+}
@@ -5,18 +5,28 @@ package events
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 )
 
+// maxPendingEvents limits events kept for a subscriber which does not read them,
+// when exceeded pending events are collapsed into a single full event
+const maxPendingEvents = 64
+
 // ISubscription defines the contract for subscription objects
 type ISubscription interface {
 	// Chan returns a read-only channel for self-handling events
 	Chan() <-chan struct{}
 	// Cancel unsubscribes and closes the channel. Safe for repeated calls
 	Cancel()
+	// Events returns events published since the previous call and clears them
+	Events() []Event
 	// Watch starts a goroutine that calls cb on each event
 	// If callNow is true, cb is called immediately
 	// When parentCtx finishes, the subscription is automatically cancelled
 	Watch(parentCtx context.Context, cb func(), callNow bool) ISubscription
+	// WatchEvents is like Watch, but passes events published since the previous call to cb
+	// If callNow is true, cb is called immediately with no events
+	WatchEvents(parentCtx context.Context, cb func([]Event), callNow bool) ISubscription
 }
 
 // ISubscriptionManager defines the contract for managing subscriptions
@@ -27,6 +37,8 @@ type ISubscriptionManager interface {
 	Unsubscribe(ch chan struct{})
 	// Emit sends notification to all subscribers (non-blocking if their channel is full)
 	Emit(ctx context.Context)
+	// Publish sends event to all subscribers (non-blocking if their channel is full)
+	Publish(ctx context.Context, event Event)
 }
 
 type Subscription struct {
@@ -34,6 +46,11 @@ type Subscription struct {
 	mgr    *SubscriptionManager
 	cancel context.CancelFunc
 	once   sync.Once
+
+	pending struct {
+		sync.Mutex
+		events []Event
+	}
 }
 
 // Chan returns a read-only channel for self-handling events.
@@ -49,15 +66,43 @@ func (s *Subscription) Cancel() {
 	})
 }
 
+// Events returns events published since the previous call and clears them.
+func (s *Subscription) Events() []Event {
+	s.pending.Lock()
+	defer s.pending.Unlock()
+
+	events := s.pending.events
+	s.pending.events = nil
+	return events
+}
+
+// push stores event until it is read by the subscriber
+func (s *Subscription) push(event Event) {
+	s.pending.Lock()
+	defer s.pending.Unlock()
+
+	if len(s.pending.events) >= maxPendingEvents {
+		s.pending.events = []Event{{Version: event.Version}}
+		return
+	}
+	s.pending.events = append(s.pending.events, event)
+}
+
 // Watch starts a goroutine that calls cb on each event.
 // If callNow is true, cb is called immediately.
 // When parentCtx finishes, the subscription is automatically cancelled.
 func (s *Subscription) Watch(parentCtx context.Context, cb func(), callNow bool) ISubscription {
+	return s.WatchEvents(parentCtx, func([]Event) { cb() }, callNow)
+}
+
+// WatchEvents is like Watch, but passes events published since the previous call to cb.
+// If callNow is true, cb is called immediately with no events.
+func (s *Subscription) WatchEvents(parentCtx context.Context, cb func([]Event), callNow bool) ISubscription {
 	ctx, cancel := context.WithCancel(parentCtx)
 	s.cancel = cancel
 
 	if callNow {
-		cb()
+		cb(nil)
 	}
 
 	go func(ctx context.Context) {
@@ -67,7 +112,7 @@ func (s *Subscription) Watch(parentCtx context.Context, cb func(), callNow bool)
 			case <-ctx.Done():
 				return
 			case <-s.ch:
-				cb()
+				cb(s.Events())
 			}
 		}
 	}(ctx)
@@ -77,23 +122,24 @@ func (s *Subscription) Watch(parentCtx context.Context, cb func(), callNow bool)
 
 type SubscriptionManager struct {
 	mu          sync.RWMutex
-	subscribers map[chan struct{}]struct{}
+	subscribers map[chan struct{}]*Subscription
+	version     atomic.Uint64
 }
 
 func NewSubscriptionManager() *SubscriptionManager {
 	return &SubscriptionManager{
-		subscribers: make(map[chan struct{}]struct{}),
+		subscribers: make(map[chan struct{}]*Subscription),
 	}
 }
 
 func (m *SubscriptionManager) Subscribe() ISubscription {
-	ch := make(chan struct{}, 1)
+	sub := &Subscription{ch: make(chan struct{}, 1), mgr: m}
 
 	m.mu.Lock()
-	m.subscribers[ch] = struct{}{}
+	m.subscribers[sub.ch] = sub
 	m.mu.Unlock()
 
-	return &Subscription{ch: ch, mgr: m}
+	return sub
 }
 
 func (m *SubscriptionManager) Unsubscribe(ch chan struct{}) {
@@ -105,17 +151,37 @@ func (m *SubscriptionManager) Unsubscribe(ch chan struct{}) {
 	m.mu.Unlock()
 }
 
+// Version returns the version of the last published event.
+func (m *SubscriptionManager) Version() uint64 {
+	return m.version.Load()
+}
+
 // Emit sends notification to all subscribers (non-blocking if their channel is full).
+// Subscribers receive a full event, i.e. everything may have changed.
 func (m *SubscriptionManager) Emit(ctx context.Context) {
+	m.Publish(ctx, Event{})
+}
+
+// Publish sends event to all subscribers (non-blocking if their channel is full).
+// Event version is assigned by the manager.
+func (m *SubscriptionManager) Publish(ctx context.Context, event Event) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	for sub := range m.subscribers {
+	event.Version = m.version.Add(1)
+
+	for ch, sub := range m.subscribers {
 		select {
 		case <-ctx.Done():
 			// Stop sending notifications when the context is cancelled
 			return
-		case sub <- struct{}{}:
+		default:
+		}
+
+		sub.push(event)
+
+		select {
+		case ch <- struct{}{}:
 			// Notified successfully
 		default:
 			// Skip notification if the subscriber's channel is full (non-blocking)
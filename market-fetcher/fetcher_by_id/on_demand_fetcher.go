@@ -0,0 +1,78 @@
+package fetcher_by_id
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"golang.org/x/time/rate"
+
+	"github.com/status-im/market-proxy/config"
+)
+
+var (
+	// ErrUnknownID is returned for IDs which are not known to the IDs provider
+	ErrUnknownID = errors.New("unknown id")
+
+	// ErrNotCached is returned for known IDs which are not cached yet
+	ErrNotCached = errors.New("item not found")
+
+	// ErrUpstreamFetch is returned when fetching a missing ID from upstream fails
+	ErrUpstreamFetch = errors.New("upstream fetch failed")
+
+	// errOnDemandBudgetExceeded is returned when on-demand fetch has no upstream requests left
+	errOnDemandBudgetExceeded = errors.New("on-demand budget exceeded")
+)
+
+// IKnownIdsProvider tells IDs known upstream from unknown ones, used to tell unknown IDs from not cached ones
+type IKnownIdsProvider interface {
+	// IsKnown returns false only if known IDs are loaded and do not contain the ID
+	IsKnown(id string) bool
+}
+
+// OnDemandFetcher fetches single IDs missing in cache at request time
+type OnDemandFetcher struct {
+	name      string
+	batchMode bool
+	fetcher   IGenericFetcher
+	limiter   *rate.Limiter
+}
+
+// NewOnDemandFetcher creates a new on-demand fetcher with its own upstream requests budget
+func NewOnDemandFetcher(cfg *config.FetcherByIdConfig, fetcher IGenericFetcher) *OnDemandFetcher {
+	rpm := cfg.OnDemand.GetRequestsPerMinute()
+	burst := rpm / 10
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &OnDemandFetcher{
+		name:      cfg.Name,
+		batchMode: cfg.IsBatchMode(),
+		fetcher:   fetcher,
+		limiter:   rate.NewLimiter(rate.Limit(float64(rpm)/60.0), burst),
+	}
+}
+
+// Fetch fetches data of a single ID within the on-demand budget
+func (f *OnDemandFetcher) Fetch(id string) ([]byte, error) {
+	if !f.limiter.Allow() {
+		return nil, errOnDemandBudgetExceeded
+	}
+
+	log.Printf("%s: Fetching missing item on demand: %s", f.name, id)
+
+	if !f.batchMode {
+		return f.fetcher.FetchSingle(id)
+	}
+
+	data, err := f.fetcher.FetchBatch([]string{id})
+	if err != nil {
+		return nil, err
+	}
+	item, exists := data[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrNotCached, id)
+	}
+	return item, nil
+}
@@ -0,0 +1,75 @@
+package fetcher_by_id
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFetcher implements IGenericFetcher for testing
+type stubFetcher struct {
+	single map[string][]byte
+	err    error
+	calls  int
+}
+
+func (f *stubFetcher) FetchSingle(id string) ([]byte, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.single[id], nil
+}
+
+func (f *stubFetcher) FetchBatch(ids []string) (map[string][]byte, error) {
+	f.calls++
+	result := make(map[string][]byte)
+	for _, id := range ids {
+		if data, ok := f.single[id]; ok {
+			result[id] = data
+		}
+	}
+	return result, nil
+}
+
+func (f *stubFetcher) Healthy() bool {
+	return true
+}
+
+func TestOnDemandFetcher_Fetch(t *testing.T) {
+	cfg := createTestGenericConfig()
+	fetcher := &stubFetcher{single: map[string][]byte{"bitcoin": []byte(`{"id":"bitcoin"}`)}}
+
+	data, err := NewOnDemandFetcher(cfg, fetcher).Fetch("bitcoin")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"id":"bitcoin"}`), data)
+}
+
+func TestOnDemandFetcher_Fetch_BatchMode(t *testing.T) {
+	cfg := createTestGenericConfig()
+	cfg.EndpointPath = "/api/v3/simple/price?ids={{ids_list}}"
+	fetcher := &stubFetcher{single: map[string][]byte{"bitcoin": []byte(`{"usd":1}`)}}
+	onDemand := NewOnDemandFetcher(cfg, fetcher)
+
+	data, err := onDemand.Fetch("bitcoin")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"usd":1}`), data)
+
+	_, err = onDemand.Fetch("unknown")
+	assert.ErrorIs(t, err, ErrNotCached)
+}
+
+func TestOnDemandFetcher_Fetch_BudgetExceeded(t *testing.T) {
+	cfg := createTestGenericConfig()
+	cfg.OnDemand.RequestsPerMinute = 1
+	fetcher := &stubFetcher{single: map[string][]byte{"bitcoin": []byte(`{}`)}}
+	onDemand := NewOnDemandFetcher(cfg, fetcher)
+
+	_, err := onDemand.Fetch("bitcoin")
+	require.NoError(t, err)
+
+	_, err = onDemand.Fetch("bitcoin")
+	assert.ErrorIs(t, err, errOnDemandBudgetExceeded)
+	assert.Equal(t, 1, fetcher.calls)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
 	"github.com/status-im/market-proxy/scheduler"
 )
@@ -17,17 +19,21 @@ type TierState struct {
 	LastUpdate      time.Time
 	UpdateStartTime *time.Time
 	IsUpdating      bool
+	Ids             []string // IDs fetched during the last update
+	Restored        bool     // State was restored from snapshot and not refreshed yet
 }
 
 // PeriodicUpdater handles periodic fetching and updating of data
 type PeriodicUpdater struct {
 	cfg           *config.FetcherByIdConfig
+	cfgMu         sync.RWMutex
 	client        *Client
 	chunksFetcher *ChunksFetcher
 	metricsWriter *metrics.MetricsWriter
 	onUpdated     UpdateCallback
 	scheduler     *scheduler.Scheduler
 	initialized   atomic.Bool
+	restored      atomic.Bool
 
 	idsProvider   IIdsProvider
 	idsProviderMu sync.RWMutex
@@ -69,6 +75,40 @@ func NewPeriodicUpdater(
 	return u
 }
 
+// getConfig returns the current fetcher config
+func (u *PeriodicUpdater) getConfig() *config.FetcherByIdConfig {
+	u.cfgMu.RLock()
+	defer u.cfgMu.RUnlock()
+	return u.cfg
+}
+
+// SetConfig replaces the fetcher config, new tiers and intervals are picked up by the next tiers check.
+// States of tiers which are not configured anymore are dropped.
+func (u *PeriodicUpdater) SetConfig(cfg *config.FetcherByIdConfig) {
+	u.cfgMu.Lock()
+	u.cfg = cfg
+	u.cfgMu.Unlock()
+
+	configured := make(map[string]bool, len(cfg.Tiers))
+	for _, tier := range cfg.Tiers {
+		configured[tier.Name] = true
+	}
+
+	u.tierStatesMu.Lock()
+	defer u.tierStatesMu.Unlock()
+	for _, tier := range cfg.Tiers {
+		if u.tierStates[tier.Name] == nil {
+			u.tierStates[tier.Name] = &TierState{}
+		}
+	}
+	for name := range u.tierStates {
+		if !configured[name] {
+			log.Printf("%s: Tier '%s' removed from config, dropping its state", cfg.Name, name)
+			delete(u.tierStates, name)
+		}
+	}
+}
+
 func (u *PeriodicUpdater) SetIdsProvider(provider IIdsProvider) {
 	u.idsProviderMu.Lock()
 	defer u.idsProviderMu.Unlock()
@@ -82,11 +122,12 @@ func (u *PeriodicUpdater) SetExtraIdsProvider(provider IIdsProvider) {
 }
 
 func (u *PeriodicUpdater) Start(ctx context.Context) error {
-	if err := u.cfg.Validate(); err != nil {
+	cfg := u.getConfig()
+	if err := cfg.Validate(); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	log.Printf("%s: Starting periodic updater with %d tiers", u.cfg.Name, len(u.cfg.Tiers))
+	log.Printf("%s: Starting periodic updater with %d tiers", cfg.Name, len(cfg.Tiers))
 
 	u.scheduler = scheduler.New(2*time.Second, func(ctx context.Context) {
 		u.checkAndUpdateTiers(ctx, false)
@@ -107,15 +148,152 @@ func (u *PeriodicUpdater) IsInitialized() bool {
 	return u.initialized.Load()
 }
 
+// IsRestored returns true if tier states were restored from snapshot
+func (u *PeriodicUpdater) IsRestored() bool {
+	return u.restored.Load()
+}
+
+// GetTierStates returns copies of current tier states
+func (u *PeriodicUpdater) GetTierStates() map[string]TierState {
+	u.tierStatesMu.RLock()
+	defer u.tierStatesMu.RUnlock()
+
+	states := make(map[string]TierState, len(u.tierStates))
+	for name, state := range u.tierStates {
+		states[name] = *state
+	}
+	return states
+}
+
+// RestoreTierState sets tier state from snapshot so the tier is not refetched before its interval
+func (u *PeriodicUpdater) RestoreTierState(tierName string, lastUpdate time.Time, ids []string) {
+	u.tierStatesMu.Lock()
+	defer u.tierStatesMu.Unlock()
+
+	state := u.tierStates[tierName]
+	if state == nil {
+		return
+	}
+	state.LastUpdate = lastUpdate
+	state.Ids = ids
+	state.Restored = true
+	u.restored.Store(true)
+}
+
+// HasStaleRestoredData returns true if data restored from snapshot is older
+// than its tier update interval and hasn't been refreshed yet
+func (u *PeriodicUpdater) HasStaleRestoredData() bool {
+	u.tierStatesMu.RLock()
+	defer u.tierStatesMu.RUnlock()
+
+	now := time.Now()
+	for _, tier := range u.getConfig().Tiers {
+		state := u.tierStates[tier.Name]
+		if state != nil && state.Restored && now.Sub(state.LastUpdate) >= tier.UpdateInterval {
+			return true
+		}
+	}
+	return false
+}
+
+// TierUpdate returns the last update time and update interval of the tier which fetched the ID.
+// ok is false if the ID was not fetched by any tier.
+func (u *PeriodicUpdater) TierUpdate(id string) (updatedAt time.Time, interval time.Duration, ok bool) {
+	cfg := u.getConfig()
+
+	u.tierStatesMu.RLock()
+	defer u.tierStatesMu.RUnlock()
+
+	for _, tier := range cfg.Tiers {
+		state := u.tierStates[tier.Name]
+		if state != nil && !state.LastUpdate.IsZero() && slices.Contains(state.Ids, id) {
+			return state.LastUpdate, tier.UpdateInterval, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
 func (u *PeriodicUpdater) ForceUpdate(ctx context.Context) error {
 	u.checkAndUpdateTiers(ctx, true)
 	return nil
 }
 
+// TierStatuses returns update status of configured tiers
+func (u *PeriodicUpdater) TierStatuses() []interfaces.TierStatus {
+	u.tierStatesMu.RLock()
+	defer u.tierStatesMu.RUnlock()
+
+	cfg := u.getConfig()
+	statuses := make([]interfaces.TierStatus, 0, len(cfg.Tiers))
+	for _, tier := range cfg.Tiers {
+		status := interfaces.TierStatus{Name: tier.Name}
+		if state := u.tierStates[tier.Name]; state != nil {
+			status.LastUpdate = state.LastUpdate
+			status.ItemCount = len(state.Ids)
+			status.UpdatingSince = state.UpdateStartTime
+			status.Restored = state.Restored
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// RefreshTier starts an update of the named tier in background
+func (u *PeriodicUpdater) RefreshTier(ctx context.Context, name string) error {
+	cfg := u.getConfig()
+	var tier *config.GenericTier
+	for _, t := range cfg.Tiers {
+		if t.Name == name {
+			tier = &t
+			break
+		}
+	}
+	if tier == nil {
+		return fmt.Errorf("%w: %s", interfaces.ErrUnknownTier, name)
+	}
+
+	u.tierStatesMu.RLock()
+	state := u.tierStates[name]
+	isUpdating := state != nil && state.IsUpdating
+	u.tierStatesMu.RUnlock()
+	if isUpdating {
+		return fmt.Errorf("%w: %s", interfaces.ErrTierUpdating, name)
+	}
+
+	allIds, err := u.getAllIds()
+	if err != nil {
+		return fmt.Errorf("failed to get IDs: %w", err)
+	}
+
+	log.Printf("%s: Force updating tier '%s'", cfg.Name, name)
+	go func(t config.GenericTier) {
+		if err := u.fetchAndUpdateTier(ctx, t, allIds); err != nil {
+			log.Printf("%s: Error updating tier '%s': %v", cfg.Name, t.Name, err)
+		}
+	}(*tier)
+	return nil
+}
+
+// RefreshID fetches data of a single ID in background and passes it to the update callback
+func (u *PeriodicUpdater) RefreshID(ctx context.Context, id string) {
+	go func() {
+		data, err := u.chunksFetcher.FetchData(ctx, []string{id}, nil)
+		if err != nil {
+			log.Printf("%s: Failed to refresh '%s': %v", u.getConfig().Name, id, err)
+			return
+		}
+
+		if len(data) > 0 && u.onUpdated != nil {
+			_ = u.onUpdated(ctx, data)
+		}
+	}()
+}
+
 func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force bool) {
+	cfg := u.getConfig()
 	allIds, err := u.getAllIds()
 	if err != nil {
-		log.Printf("%s: Failed to get IDs: %v", u.cfg.Name, err)
+		log.Printf("%s: Failed to get IDs: %v", cfg.Name, err)
 		return
 	}
 
@@ -125,7 +303,7 @@ func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force bool) {
 
 	now := time.Now()
 
-	for _, tier := range u.cfg.Tiers {
+	for _, tier := range cfg.Tiers {
 		shouldUpdate := false
 		var isUpdating bool
 
@@ -133,7 +311,6 @@ func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force bool) {
 		state := u.tierStates[tier.Name]
 		if state == nil {
 			state = &TierState{}
-			u.tierStates[tier.Name] = state
 		}
 		lastUpdate := state.LastUpdate
 		isUpdating = state.IsUpdating
@@ -147,7 +324,7 @@ func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force bool) {
 
 			if updateDuration > maxUpdateDuration {
 				log.Printf("%s: WARNING: Tier '%s' update stuck for %v, resetting...",
-					u.cfg.Name, tier.Name, updateDuration)
+					cfg.Name, tier.Name, updateDuration)
 				isUpdating = false
 				go u.setTierUpdating(tier.Name, false)
 			}
@@ -163,7 +340,7 @@ func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force bool) {
 		if shouldUpdate {
 			go func(t config.GenericTier) {
 				if err := u.fetchAndUpdateTier(ctx, t, allIds); err != nil {
-					log.Printf("%s: Error updating tier '%s': %v", u.cfg.Name, t.Name, err)
+					log.Printf("%s: Error updating tier '%s': %v", cfg.Name, t.Name, err)
 				}
 			}(tier)
 		}
@@ -173,6 +350,8 @@ func (u *PeriodicUpdater) checkAndUpdateTiers(ctx context.Context, force bool) {
 func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.GenericTier, allIds []string) error {
 	defer u.metricsWriter.TrackDataFetchCycle()()
 
+	cfg := u.getConfig()
+
 	u.setTierUpdating(tier.Name, true)
 	defer u.setTierUpdating(tier.Name, false)
 
@@ -181,19 +360,19 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ge
 
 	if fromIndex < 0 {
 		log.Printf("%s: Tier '%s' has invalid id_from (%d), must be >= 1",
-			u.cfg.Name, tier.Name, tier.IdFrom)
+			cfg.Name, tier.Name, tier.IdFrom)
 		return nil
 	}
 
 	if fromIndex >= len(allIds) {
 		log.Printf("%s: Tier '%s' id_from (%d) exceeds available IDs (%d)",
-			u.cfg.Name, tier.Name, tier.IdFrom, len(allIds))
+			cfg.Name, tier.Name, tier.IdFrom, len(allIds))
 		return nil
 	}
 
 	if toIndex < fromIndex {
 		log.Printf("%s: Tier '%s' has invalid id_to (%d), must be >= id_from (%d)",
-			u.cfg.Name, tier.Name, tier.IdTo, tier.IdFrom)
+			cfg.Name, tier.Name, tier.IdTo, tier.IdFrom)
 		return nil
 	}
 
@@ -204,7 +383,7 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ge
 	tierIds := allIds[fromIndex : toIndex+1]
 
 	log.Printf("%s: Fetching data for tier '%s' with %d IDs (range: %d-%d)",
-		u.cfg.Name, tier.Name, len(tierIds), tier.IdFrom, tier.IdTo)
+		cfg.Name, tier.Name, len(tierIds), tier.IdFrom, tier.IdTo)
 
 	data, err := u.chunksFetcher.FetchData(ctx, tierIds, func(chunkData map[string][]byte) {
 		if u.onUpdated != nil {
@@ -219,13 +398,13 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ge
 		extraData, extraErr := u.fetchExtraIds(ctx, data)
 		if extraErr != nil {
 			log.Printf("%s: Failed to fetch extra IDs for tier '%s': %v",
-				u.cfg.Name, tier.Name, extraErr)
+				cfg.Name, tier.Name, extraErr)
 		} else if len(extraData) > 0 {
 			for id, d := range extraData {
 				data[id] = d
 			}
 			log.Printf("%s: Fetched %d extra IDs for tier '%s'",
-				u.cfg.Name, len(extraData), tier.Name)
+				cfg.Name, len(extraData), tier.Name)
 		}
 	}
 
@@ -238,6 +417,11 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ge
 	u.tierStatesMu.Lock()
 	if state, exists := u.tierStates[tier.Name]; exists {
 		state.LastUpdate = time.Now()
+		state.Restored = false
+		state.Ids = make([]string, 0, len(data))
+		for id := range data {
+			state.Ids = append(state.Ids, id)
+		}
 	}
 	u.tierStatesMu.Unlock()
 
@@ -249,7 +433,7 @@ func (u *PeriodicUpdater) fetchAndUpdateTier(ctx context.Context, tier config.Ge
 
 	u.initialized.Store(true)
 
-	log.Printf("%s: Updated tier '%s' with %d items", u.cfg.Name, tier.Name, len(data))
+	log.Printf("%s: Updated tier '%s' with %d items", cfg.Name, tier.Name, len(data))
 	return nil
 }
 
@@ -271,6 +455,7 @@ func (u *PeriodicUpdater) fetchExtraIds(ctx context.Context, existingData map[st
 		return nil, nil
 	}
 
+	cfg := u.getConfig()
 	var missingIds []string
 	for _, id := range extraIds {
 		if _, exists := existingData[id]; !exists {
@@ -279,11 +464,11 @@ func (u *PeriodicUpdater) fetchExtraIds(ctx context.Context, existingData map[st
 	}
 
 	if len(missingIds) == 0 {
-		log.Printf("%s: All extra IDs already fetched in tier", u.cfg.Name)
+		log.Printf("%s: All extra IDs already fetched in tier", cfg.Name)
 		return nil, nil
 	}
 
-	log.Printf("%s: Fetching %d extra IDs", u.cfg.Name, len(missingIds))
+	log.Printf("%s: Fetching %d extra IDs", cfg.Name, len(missingIds))
 
 	return u.chunksFetcher.FetchData(ctx, missingIds, func(chunkData map[string][]byte) {
 		if u.onUpdated != nil {
@@ -298,6 +483,10 @@ func (u *PeriodicUpdater) setTierUpdating(tierName string, updating bool) {
 
 	state := u.tierStates[tierName]
 	if state == nil {
+		// Tier removed from config while it was updating
+		if !updating {
+			return
+		}
 		state = &TierState{}
 		u.tierStates[tierName] = state
 	}
@@ -320,6 +509,6 @@ func (u *PeriodicUpdater) getAllIds() ([]string, error) {
 		return nil, fmt.Errorf("IDs provider not set")
 	}
 
-	limit := u.cfg.GetMaxIdLimit()
+	limit := u.getConfig().GetMaxIdLimit()
 	return provider.GetIds(limit)
 }
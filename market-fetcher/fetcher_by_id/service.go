@@ -2,25 +2,43 @@ package fetcher_by_id
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/status-im/market-proxy/cache"
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/events"
 	"github.com/status-im/market-proxy/interfaces"
 	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/snapshot"
 )
 
+// tierSnapshot is a persisted form of tier state with cached data of its IDs
+type tierSnapshot struct {
+	LastUpdate time.Time                  `json:"last_update"`
+	Data       map[string]json.RawMessage `json:"data"`
+}
+
 // Service manages id-parametrized data fetching with caching
 type Service struct {
 	cfg                 *config.FetcherByIdConfig
+	cfgMu               sync.RWMutex
 	globalCfg           *config.Config
 	client              *Client
 	cache               cache.ICache
 	metricsWriter       *metrics.MetricsWriter
 	subscriptionManager *events.SubscriptionManager
 	periodicUpdater     *PeriodicUpdater
+	snapshotStore       *snapshot.Store
+	snapshotSaver       *snapshot.Saver
+	onDemandFetcher     *OnDemandFetcher // nil if on-demand fetching is disabled
+	knownIdsProvider    IKnownIdsProvider
+	// ctx is the service lifetime context used for background refreshes
+	ctx context.Context
 }
 
 func NewService(globalCfg *config.Config, fetcherCfg *config.FetcherByIdConfig, cacheService cache.ICache) *Service {
@@ -36,6 +54,10 @@ func NewService(globalCfg *config.Config, fetcherCfg *config.FetcherByIdConfig,
 		subscriptionManager: events.NewSubscriptionManager(),
 	}
 
+	if fetcherCfg.OnDemand.Enabled {
+		service.onDemandFetcher = NewOnDemandFetcher(fetcherCfg, client)
+	}
+
 	service.periodicUpdater = NewPeriodicUpdater(
 		fetcherCfg,
 		client,
@@ -46,6 +68,29 @@ func NewService(globalCfg *config.Config, fetcherCfg *config.FetcherByIdConfig,
 	return service
 }
 
+// getConfig returns the current fetcher config
+func (s *Service) getConfig() *config.FetcherByIdConfig {
+	s.cfgMu.RLock()
+	defer s.cfgMu.RUnlock()
+	return s.cfg
+}
+
+// SetConfig applies reloaded fetcher config: tiers, their update intervals and TTL are changed live.
+// Name, endpoint, params, chunk size and on-demand settings are applied only on restart.
+// Invalid config is rejected and the previous config is kept.
+func (s *Service) SetConfig(fetcherCfg *config.FetcherByIdConfig) {
+	if err := fetcherCfg.Validate(); err != nil {
+		log.Printf("%s: Invalid reloaded configuration, keeping previous: %v", s.getConfig().Name, err)
+		return
+	}
+
+	s.cfgMu.Lock()
+	s.cfg = fetcherCfg
+	s.cfgMu.Unlock()
+
+	s.periodicUpdater.SetConfig(fetcherCfg)
+}
+
 func (s *Service) SetIdsProvider(provider IIdsProvider) {
 	s.periodicUpdater.SetIdsProvider(provider)
 }
@@ -55,13 +100,24 @@ func (s *Service) SetExtraIdsProvider(provider IIdsProvider) {
 	s.periodicUpdater.SetExtraIdsProvider(provider)
 }
 
+// SetKnownIdsProvider sets the provider of known IDs, used to reject unknown IDs on cache miss
+func (s *Service) SetKnownIdsProvider(provider IKnownIdsProvider) {
+	s.knownIdsProvider = provider
+}
+
+// SetSnapshotStore enables warm-start snapshots of tier states and cached data
+func (s *Service) SetSnapshotStore(store *snapshot.Store) {
+	s.snapshotStore = store
+}
+
 func (s *Service) onDataUpdated(ctx context.Context, data map[string][]byte) error {
+	cfg := s.getConfig()
 	if err := s.cacheByID(data); err != nil {
-		log.Printf("%s: Failed to cache data: %v", s.cfg.Name, err)
+		log.Printf("%s: Failed to cache data: %v", cfg.Name, err)
 		return err
 	}
 
-	log.Printf("%s: Cache update complete - items: %d", s.cfg.Name, len(data))
+	log.Printf("%s: Cache update complete - items: %d", cfg.Name, len(data))
 	s.subscriptionManager.Emit(ctx)
 
 	return nil
@@ -72,13 +128,14 @@ func (s *Service) cacheByID(data map[string][]byte) error {
 		return nil
 	}
 
+	cfg := s.getConfig()
 	cacheData := make(map[string][]byte)
 	for id, rawData := range data {
-		cacheKey := s.cfg.BuildCacheKey(id)
+		cacheKey := cfg.BuildCacheKey(id)
 		cacheData[cacheKey] = rawData
 	}
 
-	err := s.cache.Set(cacheData, s.cfg.GetTTL())
+	err := s.cache.Set(cacheData, cfg.GetTTL())
 	if err != nil {
 		return fmt.Errorf("failed to store in cache: %w", err)
 	}
@@ -91,34 +148,176 @@ func (s *Service) Start(ctx context.Context) error {
 		return fmt.Errorf("cache dependency not provided")
 	}
 
-	log.Printf("%s: Starting service (mode: %s)", s.cfg.Name, s.cfg.GetFetchMode())
+	cfg := s.getConfig()
+	log.Printf("%s: Starting service (mode: %s)", cfg.Name, cfg.GetFetchMode())
+	s.ctx = ctx
+
+	s.restoreSnapshot()
+	s.snapshotSaver = snapshot.NewSaver(s.snapshotStore, cfg.Name, s.snapshot)
+	s.snapshotSaver.Start(ctx)
+
 	return s.periodicUpdater.Start(ctx)
 }
 
 func (s *Service) Stop() {
 	s.periodicUpdater.Stop()
-	log.Printf("%s: Service stopped", s.cfg.Name)
+	if s.snapshotSaver != nil {
+		s.snapshotSaver.Stop()
+	}
+	log.Printf("%s: Service stopped", s.getConfig().Name)
+}
+
+// restoreSnapshot puts data saved by a previous run back to cache with its remaining TTL
+// and restores tier update times
+func (s *Service) restoreSnapshot() {
+	cfg := s.getConfig()
+	var tiers map[string]tierSnapshot
+	found, err := s.snapshotStore.Load(cfg.Name, &tiers)
+	if err != nil {
+		log.Printf("%s: Failed to restore snapshot: %v", cfg.Name, err)
+		return
+	}
+	if !found {
+		return
+	}
+
+	for _, tier := range cfg.Tiers {
+		saved, exists := tiers[tier.Name]
+		if !exists || len(saved.Data) == 0 {
+			continue
+		}
+
+		ttl := cfg.GetTTL() - time.Since(saved.LastUpdate)
+		if ttl <= 0 {
+			continue
+		}
+
+		cacheData := make(map[string][]byte, len(saved.Data))
+		ids := make([]string, 0, len(saved.Data))
+		for id, raw := range saved.Data {
+			cacheData[cfg.BuildCacheKey(id)] = raw
+			ids = append(ids, id)
+		}
+		if err := s.cache.Set(cacheData, ttl); err != nil {
+			log.Printf("%s: Failed to cache restored data: %v", cfg.Name, err)
+			continue
+		}
+
+		s.periodicUpdater.RestoreTierState(tier.Name, saved.LastUpdate, ids)
+		log.Printf("%s: Restored tier '%s' from snapshot with %d items", cfg.Name, tier.Name, len(ids))
+	}
+}
+
+// snapshot returns tier states together with cached data of their IDs
+func (s *Service) snapshot() (interface{}, error) {
+	tiers := make(map[string]tierSnapshot)
+	for name, state := range s.periodicUpdater.GetTierStates() {
+		if len(state.Ids) == 0 {
+			continue
+		}
+
+		found, _, _ := s.GetMultiple(state.Ids)
+		data := make(map[string]json.RawMessage, len(found))
+		for id, raw := range found {
+			data[id] = raw
+		}
+		tiers[name] = tierSnapshot{LastUpdate: state.LastUpdate, Data: data}
+	}
+
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+	return tiers, nil
 }
 
 // GetByID returns cached data for a specific ID (for HTTP API)
 func (s *Service) GetByID(id string) ([]byte, interfaces.CacheStatus, error) {
-	cacheKey := s.cfg.BuildCacheKey(id)
+	cacheKey := s.getConfig().BuildCacheKey(id)
 
 	cachedData, missingKeys, err := s.cache.Get([]string{cacheKey})
 	if err != nil {
 		return nil, interfaces.CacheStatusMiss, fmt.Errorf("failed to get from cache: %w", err)
 	}
 
-	if len(missingKeys) > 0 {
-		return nil, interfaces.CacheStatusMiss, fmt.Errorf("item not found: %s", id)
+	data, exists := cachedData[cacheKey]
+	if len(missingKeys) > 0 || !exists {
+		return s.getMissing(id)
 	}
 
-	data, exists := cachedData[cacheKey]
+	return data, s.markStaleRestoredData(interfaces.CacheStatusFull), nil
+}
+
+// UpdatedAt returns the last update time and update interval of the tier which fetched the ID.
+// Zero time is returned for IDs which are not fetched by tiers.
+func (s *Service) UpdatedAt(id string) (time.Time, time.Duration) {
+	updatedAt, interval, ok := s.periodicUpdater.TierUpdate(id)
+	if !ok {
+		return time.Time{}, 0
+	}
+	return updatedAt, interval
+}
+
+// getMissing handles a cache miss: unknown IDs are rejected, known IDs are fetched on demand if enabled
+func (s *Service) getMissing(id string) ([]byte, interfaces.CacheStatus, error) {
+	if !s.isKnownID(id) {
+		return nil, interfaces.CacheStatusMiss, fmt.Errorf("%w: %s", ErrUnknownID, id)
+	}
+
+	if s.onDemandFetcher == nil {
+		return nil, interfaces.CacheStatusMiss, fmt.Errorf("%w: %s", ErrNotCached, id)
+	}
+
+	cfg := s.getConfig()
+	cacheKey := cfg.BuildCacheKey(id)
+	loader := func(missingKeys []string) (map[string][]byte, error) {
+		data, err := s.onDemandFetcher.Fetch(id)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{cacheKey: data}, nil
+	}
+
+	// Concurrent requests for the same ID share a single upstream fetch
+	loadedData, err := s.cache.GetOrLoad([]string{cacheKey}, loader, true, cfg.GetTTL())
+	if err != nil {
+		log.Printf("%s: Failed to fetch %s on demand: %v", cfg.Name, id, err)
+		if errors.Is(err, errOnDemandBudgetExceeded) || errors.Is(err, ErrNotCached) {
+			return nil, interfaces.CacheStatusMiss, fmt.Errorf("%w: %s", ErrNotCached, id)
+		}
+		return nil, interfaces.CacheStatusMiss, fmt.Errorf("%w: %s: %v", ErrUpstreamFetch, id, err)
+	}
+
+	data, exists := loadedData[cacheKey]
 	if !exists {
-		return nil, interfaces.CacheStatusMiss, fmt.Errorf("item not found: %s", id)
+		return nil, interfaces.CacheStatusMiss, fmt.Errorf("%w: %s", ErrNotCached, id)
+	}
+
+	return data, interfaces.CacheStatusMiss, nil
+}
+
+// isKnownID returns false only if known IDs are loaded and do not contain the ID
+func (s *Service) isKnownID(id string) bool {
+	return s.knownIdsProvider == nil || s.knownIdsProvider.IsKnown(id)
+}
+
+// UnknownIds returns IDs which are not known to the known IDs provider.
+// Returns nil if the provider is not set or known IDs are not loaded yet.
+func (s *Service) UnknownIds(ids []string) []string {
+	var unknown []string
+	for _, id := range ids {
+		if !s.isKnownID(id) {
+			unknown = append(unknown, id)
+		}
 	}
+	return unknown
+}
 
-	return data, interfaces.CacheStatusFull, nil
+// markStaleRestoredData downgrades cache status while data restored from snapshot is being refreshed
+func (s *Service) markStaleRestoredData(status interfaces.CacheStatus) interfaces.CacheStatus {
+	if status != interfaces.CacheStatusMiss && s.periodicUpdater.HasStaleRestoredData() {
+		return interfaces.CacheStatusStale
+	}
+	return status
 }
 
 func (s *Service) GetMultiple(ids []string) (map[string][]byte, []string, interfaces.CacheStatus) {
@@ -126,17 +325,18 @@ func (s *Service) GetMultiple(ids []string) (map[string][]byte, []string, interf
 		return make(map[string][]byte), nil, interfaces.CacheStatusFull
 	}
 
+	cfg := s.getConfig()
 	cacheKeys := make([]string, len(ids))
 	keyToID := make(map[string]string)
 	for i, id := range ids {
-		cacheKey := s.cfg.BuildCacheKey(id)
+		cacheKey := cfg.BuildCacheKey(id)
 		cacheKeys[i] = cacheKey
 		keyToID[cacheKey] = id
 	}
 
 	cachedData, missingKeys, err := s.cache.Get(cacheKeys)
 	if err != nil {
-		log.Printf("%s: Failed to get from cache: %v", s.cfg.Name, err)
+		log.Printf("%s: Failed to get from cache: %v", cfg.Name, err)
 		return nil, ids, interfaces.CacheStatusMiss
 	}
 
@@ -162,11 +362,11 @@ func (s *Service) GetMultiple(ids []string) (map[string][]byte, []string, interf
 		status = interfaces.CacheStatusMiss
 	}
 
-	return result, missing, status
+	return result, missing, s.markStaleRestoredData(status)
 }
 
 func (s *Service) Healthy() bool {
-	return s.periodicUpdater.IsInitialized()
+	return s.periodicUpdater.IsInitialized() || s.periodicUpdater.IsRestored()
 }
 
 func (s *Service) SubscribeOnUpdate() events.ISubscription {
@@ -177,10 +377,40 @@ func (s *Service) ForceUpdate(ctx context.Context) error {
 	return s.periodicUpdater.ForceUpdate(ctx)
 }
 
+// TierStatuses implements interfaces.ITiersAdmin
+func (s *Service) TierStatuses() []interfaces.TierStatus {
+	return s.periodicUpdater.TierStatuses()
+}
+
+// RefreshAll implements interfaces.ITiersAdmin
+func (s *Service) RefreshAll() error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	return s.ForceUpdate(s.ctx)
+}
+
+// RefreshTier implements interfaces.ITiersAdmin
+func (s *Service) RefreshTier(name string) error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	return s.periodicUpdater.RefreshTier(s.ctx, name)
+}
+
+// RefreshID implements interfaces.ITiersAdmin
+func (s *Service) RefreshID(id string) error {
+	if s.ctx == nil {
+		return interfaces.ErrNotStarted
+	}
+	s.periodicUpdater.RefreshID(s.ctx, id)
+	return nil
+}
+
 func (s *Service) GetName() string {
-	return s.cfg.Name
+	return s.getConfig().Name
 }
 
 func (s *Service) GetConfig() *config.FetcherByIdConfig {
-	return s.cfg
+	return s.getConfig()
 }
@@ -2,13 +2,18 @@ package fetcher_by_id
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/status-im/market-proxy/cache"
 	cache_mocks "github.com/status-im/market-proxy/cache/mocks"
 	"github.com/status-im/market-proxy/config"
 	"github.com/status-im/market-proxy/interfaces"
+	"github.com/status-im/market-proxy/snapshot"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -277,6 +282,60 @@ func TestService_CacheByID(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestService_SetConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCache := cache_mocks.NewMockICache(ctrl)
+	service := NewService(createTestGlobalConfig(), createTestGenericConfig(), mockCache)
+
+	reloaded := createTestGenericConfig()
+	reloaded.TTL = 2 * time.Hour
+	reloaded.Tiers = []config.GenericTier{
+		{Name: "top-50", IdFrom: 1, IdTo: 50, UpdateInterval: 10 * time.Minute},
+	}
+	service.SetConfig(reloaded)
+
+	// Removed tiers are dropped and new tiers are reported
+	statuses := service.TierStatuses()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "top-50", statuses[0].Name)
+	assert.NotContains(t, service.periodicUpdater.GetTierStates(), "top-100")
+
+	// Data is cached with the reloaded TTL
+	mockCache.EXPECT().Set(map[string][]byte{"test:id:bitcoin": []byte(`{}`)}, 2*time.Hour).Return(nil)
+	assert.NoError(t, service.cacheByID(map[string][]byte{"bitcoin": []byte(`{}`)}))
+
+	// Invalid config is rejected
+	invalid := createTestGenericConfig()
+	invalid.Tiers = nil
+	service.SetConfig(invalid)
+	assert.Equal(t, reloaded, service.GetConfig())
+}
+
+func TestService_RefreshBeforeStart(t *testing.T) {
+	service := NewService(createTestGlobalConfig(), createTestGenericConfig(), cache.NewService(cache.DefaultCacheConfig()))
+
+	assert.ErrorIs(t, service.RefreshAll(), interfaces.ErrNotStarted)
+	assert.ErrorIs(t, service.RefreshTier("top-100"), interfaces.ErrNotStarted)
+	assert.ErrorIs(t, service.RefreshID("bitcoin"), interfaces.ErrNotStarted)
+}
+
+func TestService_UpdatedAt(t *testing.T) {
+	service := NewService(createTestGlobalConfig(), createTestGenericConfig(), cache.NewService(cache.DefaultCacheConfig()))
+
+	lastUpdate := time.Now().Add(-10 * time.Minute)
+	service.periodicUpdater.RestoreTierState("top-100", lastUpdate, []string{"bitcoin"})
+
+	updatedAt, interval := service.UpdatedAt("bitcoin")
+	assert.Equal(t, lastUpdate, updatedAt)
+	assert.Equal(t, 30*time.Minute, interval)
+
+	// IDs which are not fetched by tiers have no update time
+	updatedAt, _ = service.UpdatedAt("ethereum")
+	assert.True(t, updatedAt.IsZero())
+}
+
 func TestService_Healthy(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -344,3 +403,90 @@ func TestService_SetExtraIdsProvider(t *testing.T) {
 		service.SetExtraIdsProvider(provider)
 	})
 }
+
+func TestService_SnapshotRestore(t *testing.T) {
+	store := snapshot.NewStore(snapshot.Config{Dir: t.TempDir()})
+	globalCfg := createTestGlobalConfig()
+	fetcherCfg := createTestGenericConfig()
+
+	// Simulate a completed tier update in the first run
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+	service := NewService(globalCfg, fetcherCfg, cacheService)
+	require.NoError(t, service.cacheByID(map[string][]byte{"bitcoin": []byte(`{"name":"Bitcoin"}`)}))
+	service.periodicUpdater.RestoreTierState("top-100", time.Now().Add(-45*time.Minute), []string{"bitcoin"})
+
+	state, err := service.snapshot()
+	require.NoError(t, err)
+	require.NoError(t, store.Save(fetcherCfg.Name, state))
+
+	// Second run restores cached data and tier state before the updater starts
+	restored := NewService(globalCfg, fetcherCfg, cache.NewService(cache.DefaultCacheConfig()))
+	restored.SetSnapshotStore(store)
+	restored.restoreSnapshot()
+
+	assert.True(t, restored.Healthy())
+	states := restored.periodicUpdater.GetTierStates()
+	assert.True(t, states["top-100"].Restored)
+	assert.Equal(t, []string{"bitcoin"}, states["top-100"].Ids)
+
+	// Restored data is within TTL but older than tier update interval
+	data, status, err := restored.GetByID("bitcoin")
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusStale, status)
+	assert.JSONEq(t, `{"name":"Bitcoin"}`, string(data))
+}
+
+// staticKnownIds implements IKnownIdsProvider for testing
+type staticKnownIds []string
+
+func (p staticKnownIds) IsKnown(id string) bool {
+	return slices.Contains(p, id)
+}
+
+func TestService_GetByID_UnknownID(t *testing.T) {
+	service := NewService(createTestGlobalConfig(), createTestGenericConfig(), cache.NewService(cache.DefaultCacheConfig()))
+	service.SetKnownIdsProvider(staticKnownIds{"bitcoin"})
+
+	_, status, err := service.GetByID("not-a-coin")
+	assert.ErrorIs(t, err, ErrUnknownID)
+	assert.Equal(t, interfaces.CacheStatusMiss, status)
+
+	_, _, err = service.GetByID("bitcoin")
+	assert.ErrorIs(t, err, ErrNotCached, "known IDs are not fetched while on-demand fetching is disabled")
+}
+
+func TestService_GetByID_OnDemand(t *testing.T) {
+	fetcherCfg := createTestGenericConfig()
+	fetcherCfg.OnDemand.Enabled = true
+	cacheService := cache.NewService(cache.DefaultCacheConfig())
+
+	service := NewService(createTestGlobalConfig(), fetcherCfg, cacheService)
+	require.NotNil(t, service.onDemandFetcher)
+	fetcher := &stubFetcher{single: map[string][]byte{"rare-coin": []byte(`{"id":"rare-coin"}`)}}
+	service.onDemandFetcher = NewOnDemandFetcher(fetcherCfg, fetcher)
+
+	data, status, err := service.GetByID("rare-coin")
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusMiss, status)
+	assert.Equal(t, []byte(`{"id":"rare-coin"}`), data)
+
+	// Fetched data is cached with the fetcher TTL
+	data, status, err = service.GetByID("rare-coin")
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.CacheStatusFull, status)
+	assert.Equal(t, []byte(`{"id":"rare-coin"}`), data)
+	assert.Equal(t, 1, fetcher.calls)
+}
+
+func TestService_GetByID_OnDemandUpstreamError(t *testing.T) {
+	fetcherCfg := createTestGenericConfig()
+	fetcherCfg.OnDemand.Enabled = true
+
+	service := NewService(createTestGlobalConfig(), fetcherCfg, cache.NewService(cache.DefaultCacheConfig()))
+	service.onDemandFetcher = NewOnDemandFetcher(fetcherCfg, &stubFetcher{err: errors.New("status 500")})
+
+	_, status, err := service.GetByID("rare-coin")
+	assert.ErrorIs(t, err, ErrUpstreamFetch)
+	assert.NotErrorIs(t, err, ErrNotCached)
+	assert.Equal(t, interfaces.CacheStatusMiss, status)
+}
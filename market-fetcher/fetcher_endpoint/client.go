@@ -0,0 +1,88 @@
+package fetcher_endpoint
+
+import (
+	"encoding/json"
+	"log"
+	"sync/atomic"
+
+	cg "github.com/status-im/market-proxy/coingecko_common"
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/metrics"
+)
+
+// IAPIClient fetches the raw response of an endpoint
+type IAPIClient interface {
+	Fetch() (json.RawMessage, error)
+	Healthy() bool
+}
+
+// CoinGeckoClient fetches a CoinGecko endpoint by path with API keys rotation and retries
+type CoinGeckoClient struct {
+	config          *config.Config
+	path            string
+	logPrefix       string
+	keyManager      cg.IAPIKeyManager
+	httpClient      *cg.HTTPClientWithRetries
+	successfulFetch atomic.Bool
+}
+
+// NewCoinGeckoClient creates a client of the endpoint at path, requests are recorded in metrics of metricsService
+func NewCoinGeckoClient(cfg *config.Config, path, logPrefix, metricsService string) *CoinGeckoClient {
+	retryOpts := cg.DefaultRetryOptions()
+	retryOpts.LogPrefix = logPrefix
+
+	metricsWriter := metrics.NewMetricsWriter(metricsService)
+
+	return &CoinGeckoClient{
+		config:     cfg,
+		path:       path,
+		logPrefix:  logPrefix,
+		keyManager: cg.NewAPIKeyManager(cfg.APITokens),
+		httpClient: cg.NewHTTPClientWithRetries(retryOpts, metricsWriter, cg.GetRateLimiterManagerInstance()),
+	}
+}
+
+func (c *CoinGeckoClient) Healthy() bool {
+	return c.successfulFetch.Load()
+}
+
+// Fetch fetches the raw response body, it is checked to be valid JSON
+func (c *CoinGeckoClient) Fetch() (json.RawMessage, error) {
+	executor := func(apiKey cg.APIKey) (interface{}, bool, error) {
+		baseURL := cg.GetApiBaseUrl(c.config, apiKey.Type)
+
+		requestBuilder := cg.NewCoingeckoRequestBuilder(baseURL, c.path).
+			WithApiKey(apiKey.Key, apiKey.Type)
+
+		request, err := requestBuilder.Build()
+		if err != nil {
+			log.Printf("%s: Error building request with key type %v: %v", c.logPrefix, apiKey.Type, err)
+			return nil, false, err
+		}
+
+		resp, body, _, err := c.httpClient.ExecuteRequest(request)
+		if err != nil {
+			return nil, false, err
+		}
+		resp.Body.Close()
+
+		var result json.RawMessage
+		if err := json.Unmarshal(body, &result); err != nil {
+			log.Printf("%s: Error parsing JSON response: %v", c.logPrefix, err)
+			return nil, false, err
+		}
+
+		c.successfulFetch.Store(true)
+		return result, true, nil
+	}
+
+	onFailed := cg.CreateFailCallback(c.keyManager)
+
+	availableKeys := c.keyManager.GetAvailableKeys()
+	result, err := cg.TryWithKeys(availableKeys, c.logPrefix, executor, onFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(json.RawMessage), nil
+}
@@ -0,0 +1,121 @@
+package fetcher_endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/scheduler"
+)
+
+// Options define a periodically fetched endpoint
+type Options struct {
+	// Name is used in logs, e.g. "Global"
+	Name string
+
+	// Path of the upstream endpoint, e.g. "/api/v3/global"
+	Path string
+
+	// MetricsService labels upstream requests metrics
+	MetricsService string
+
+	// UpdateInterval returns the interval between updates from config, it is re-read on config reload
+	UpdateInterval func(cfg *config.Config) time.Duration
+
+	// Validate rejects responses which must not replace the previous data, e.g. empty ones
+	Validate func(data json.RawMessage) error
+
+	// OnUpdated is called with every accepted response, optional
+	OnUpdated func(data json.RawMessage)
+}
+
+// Fetcher periodically fetches the raw JSON response of an endpoint and serves it as is.
+// The previous response is kept if fetching or validation fails.
+type Fetcher struct {
+	config    *config.Config
+	options   Options
+	client    IAPIClient
+	scheduler *scheduler.Scheduler
+	response  struct {
+		sync.RWMutex
+		data      json.RawMessage
+		updatedAt time.Time
+	}
+}
+
+// NewFetcher creates a fetcher of the CoinGecko endpoint defined by options
+func NewFetcher(cfg *config.Config, options Options) *Fetcher {
+	client := NewCoinGeckoClient(cfg, options.Path, "CoinGecko-"+options.Name, options.MetricsService)
+	return newFetcher(cfg, options, client)
+}
+
+func newFetcher(cfg *config.Config, options Options, client IAPIClient) *Fetcher {
+	fetcher := &Fetcher{
+		config:  cfg,
+		options: options,
+		client:  client,
+	}
+	// The scheduler is created upfront, so config reloads never race with Start
+	fetcher.scheduler = scheduler.New(options.UpdateInterval(cfg), fetcher.update)
+	return fetcher
+}
+
+// Start implements core.Interface
+func (f *Fetcher) Start(ctx context.Context) error {
+	f.scheduler.Start(ctx, true)
+	return nil
+}
+
+// Stop implements core.Interface
+func (f *Fetcher) Stop() {
+	f.scheduler.Stop()
+}
+
+// ApplyConfig implements core.IReloadable, the update interval is changed live
+func (f *Fetcher) ApplyConfig(cfg *config.Config) {
+	f.scheduler.SetInterval(f.options.UpdateInterval(cfg))
+}
+
+// update fetches the endpoint, previous data is kept if fetching fails
+func (f *Fetcher) update(ctx context.Context) {
+	data, err := f.client.Fetch()
+	if err != nil {
+		log.Printf("%s: Failed to fetch data: %v", f.options.Name, err)
+		return
+	}
+
+	if err := f.options.Validate(data); err != nil {
+		log.Printf("%s: Invalid response, keeping previous data: %v", f.options.Name, err)
+		return
+	}
+
+	f.response.Lock()
+	f.response.data = data
+	f.response.updatedAt = time.Now()
+	f.response.Unlock()
+
+	if f.options.OnUpdated != nil {
+		f.options.OnUpdated(data)
+	}
+
+	log.Printf("%s: Update complete, %d bytes", f.options.Name, len(data))
+}
+
+// Data returns the cached response and the time it was fetched, nil if not fetched yet
+func (f *Fetcher) Data() (json.RawMessage, time.Time) {
+	f.response.RLock()
+	defer f.response.RUnlock()
+
+	return f.response.data, f.response.updatedAt
+}
+
+// Healthy checks if the response is available
+func (f *Fetcher) Healthy() bool {
+	f.response.RLock()
+	defer f.response.RUnlock()
+
+	return len(f.response.data) > 0
+}
@@ -0,0 +1,110 @@
+package fetcher_endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/status-im/market-proxy/config"
+)
+
+type MockIAPIClient struct {
+	shouldFail bool
+	response   json.RawMessage
+}
+
+func (m *MockIAPIClient) Fetch() (json.RawMessage, error) {
+	if m.shouldFail {
+		return nil, fmt.Errorf("mock error")
+	}
+	return m.response, nil
+}
+
+func (m *MockIAPIClient) Healthy() bool {
+	return !m.shouldFail
+}
+
+// createTestFetcher creates a fetcher which accepts responses with non-empty "coins"
+func createTestFetcher(client IAPIClient, updated *[]json.RawMessage) *Fetcher {
+	return newFetcher(&config.Config{APITokens: &config.APITokens{}}, Options{
+		Name: "Test",
+		Path: "/api/v3/test",
+		UpdateInterval: func(cfg *config.Config) time.Duration {
+			return time.Minute
+		},
+		Validate: func(data json.RawMessage) error {
+			var response struct {
+				Coins []json.RawMessage `json:"coins"`
+			}
+			if err := json.Unmarshal(data, &response); err != nil {
+				return err
+			}
+			if len(response.Coins) == 0 {
+				return fmt.Errorf("empty response")
+			}
+			return nil
+		},
+		OnUpdated: func(data json.RawMessage) {
+			*updated = append(*updated, data)
+		},
+	}, client)
+}
+
+var testResponse = json.RawMessage(`{"coins":[{"item":{"id":"bitcoin"}}]}`)
+
+func TestFetcher_Data(t *testing.T) {
+	var updated []json.RawMessage
+	fetcher := createTestFetcher(&MockIAPIClient{response: testResponse}, &updated)
+
+	data, updatedAt := fetcher.Data()
+	assert.Nil(t, data)
+	assert.True(t, updatedAt.IsZero())
+	assert.False(t, fetcher.Healthy())
+
+	fetcher.update(context.Background())
+
+	data, updatedAt = fetcher.Data()
+	assert.True(t, fetcher.Healthy())
+	assert.JSONEq(t, string(testResponse), string(data))
+	assert.False(t, updatedAt.IsZero())
+	assert.Equal(t, []json.RawMessage{testResponse}, updated)
+}
+
+func TestFetcher_UpdateKeepsDataOnFailure(t *testing.T) {
+	var updated []json.RawMessage
+	client := &MockIAPIClient{response: testResponse}
+	fetcher := createTestFetcher(client, &updated)
+	fetcher.update(context.Background())
+
+	client.shouldFail = true
+	fetcher.update(context.Background())
+	data, _ := fetcher.Data()
+	assert.JSONEq(t, string(testResponse), string(data))
+
+	// Responses rejected by the validator are not stored
+	client.shouldFail = false
+	client.response = json.RawMessage(`{"coins":[]}`)
+	fetcher.update(context.Background())
+	data, _ = fetcher.Data()
+	assert.JSONEq(t, string(testResponse), string(data))
+	assert.Len(t, updated, 1)
+}
+
+func TestFetcher_ApplyConfigBeforeStart(t *testing.T) {
+	var updated []json.RawMessage
+	fetcher := createTestFetcher(&MockIAPIClient{response: testResponse}, &updated)
+
+	// Config reload may happen concurrently with Start
+	done := make(chan struct{})
+	go func() {
+		fetcher.ApplyConfig(&config.Config{})
+		close(done)
+	}()
+	assert.NoError(t, fetcher.Start(context.Background()))
+	<-done
+	fetcher.Stop()
+}
@@ -1,13 +1,28 @@
 package interfaces
 
+import "time"
+
 type CacheStatus string
 
 const (
 	CacheStatusFull    CacheStatus = "full"
 	CacheStatusPartial CacheStatus = "partial"
 	CacheStatusMiss    CacheStatus = "miss"
+	// CacheStatusStale data is served but is known to be outdated
+	// (e.g. restored from a snapshot or past its soft TTL and not refreshed yet)
+	CacheStatusStale CacheStatus = "stale"
 )
 
 func (cs CacheStatus) String() string {
 	return string(cs)
 }
+
+// ResponseMeta describes freshness of data served from cache
+type ResponseMeta struct {
+	CacheStatus CacheStatus
+	// Age is the age of the oldest cached item used in the response
+	Age time.Duration
+	// DerivedCurrencies are currencies whose values were converted from cached
+	// currency using exchange rates instead of being fetched from upstream
+	DerivedCurrencies []string
+}
@@ -48,6 +48,12 @@ type MarketsParams struct {
 
 	// PriceChangePercentage includes price change percentages for specific time periods
 	PriceChangePercentage []string `json:"price_change_percentage,omitempty"`
+
+	// MinMarketCap filters out coins with lower market cap (in vs_currency)
+	MinMarketCap float64 `json:"min_market_cap,omitempty"`
+
+	// MinVolume filters out coins with lower 24h volume (in vs_currency)
+	MinVolume float64 `json:"min_volume,omitempty"`
 }
 
 // MarketsResponse represents markets data response structure
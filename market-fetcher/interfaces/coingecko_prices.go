@@ -37,6 +37,10 @@ type PriceParams struct {
 
 	// Precision for decimal places (empty means full precision)
 	Precision string `json:"precision,omitempty"`
+
+	// CacheOnly returns cached prices only: missing prices are not fetched on demand
+	// and stale ones are not refreshed. Used by internal consumers like alerts.
+	CacheOnly bool `json:"-"`
 }
 
 // SimplePriceResponse represents the response format compatible with CoinGecko simple/price API
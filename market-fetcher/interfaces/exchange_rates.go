@@ -0,0 +1,7 @@
+package interfaces
+
+// ICurrencyConverter provides rates to derive values in currencies which are not cached
+type ICurrencyConverter interface {
+	// ConversionRate returns the multiplier converting values in `from` currency to fiat `to` currency
+	ConversionRate(from, to string) (float64, bool)
+}
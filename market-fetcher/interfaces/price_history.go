@@ -0,0 +1,17 @@
+package interfaces
+
+import "time"
+
+// IPriceHistoryRecorder records prices updates into local price history
+type IPriceHistoryRecorder interface {
+	// RecordPrices records raw /simple/price JSON data keyed by token ID
+	RecordPrices(pricesData map[string][]byte)
+}
+
+// IPriceHistory provides market chart data from local price history
+type IPriceHistory interface {
+	// MarketChart returns "prices", "market_caps" and "total_volumes" points as [timestamp ms, value]
+	// recorded during the last duration in buckets of step size.
+	// Returns false if the recorded history does not cover the whole range.
+	MarketChart(tokenID, currency string, duration, step time.Duration) (map[string][][2]float64, bool)
+}
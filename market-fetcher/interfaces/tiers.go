@@ -0,0 +1,39 @@
+package interfaces
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrUnknownTier is returned when a tier is not configured
+	ErrUnknownTier = errors.New("unknown tier")
+	// ErrTierUpdating is returned when a tier refresh is requested while the tier is being updated
+	ErrTierUpdating = errors.New("tier is already updating")
+	// ErrNotStarted is returned when a refresh is requested before the service is started
+	ErrNotStarted = errors.New("service is not started")
+)
+
+// TierStatus describes the state of a periodically updated tier
+type TierStatus struct {
+	Name          string     `json:"name"`
+	LastUpdate    time.Time  `json:"last_update"` // zero if never updated
+	ItemCount     int        `json:"item_count"`
+	UpdatingSince *time.Time `json:"updating_since,omitempty"` // nil if not updating
+	Restored      bool       `json:"restored,omitempty"`       // data restored from snapshot and not refreshed yet
+}
+
+// ITiersAdmin is implemented by services with periodically updated tiers, refreshes run in background
+type ITiersAdmin interface {
+	// TierStatuses returns status of configured tiers
+	TierStatuses() []TierStatus
+
+	// RefreshAll starts an update of all tiers which are not updating
+	RefreshAll() error
+
+	// RefreshTier starts an update of the named tier
+	RefreshTier(name string) error
+
+	// RefreshID fetches data of a single ID and caches it
+	RefreshID(id string) error
+}
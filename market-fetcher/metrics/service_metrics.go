@@ -19,7 +19,13 @@ const (
 	ServicePrices       = "prices"
 	ServiceMarkets      = "markets"
 	ServiceMarketCharts = "market-charts"
+	ServiceOHLC         = "ohlc"
 	ServicePlatforms    = "platforms"
+	ServiceRates        = "exchange-rates"
+	ServicePriceHistory = "price-history"
+	ServiceCategories   = "categories"
+	ServiceGlobal       = "global"
+	ServiceTrending     = "trending"
 )
 
 var (
@@ -121,6 +127,92 @@ var (
 		[]string{"service"},
 	)
 
+	// Loader calls made by cache on misses
+	// Cardinality: 1
+	CacheLoadsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "cache_loads_total",
+			Help: "Total number of loader calls made by cache on misses",
+		},
+	)
+
+	// Callers which waited for an in-flight load instead of calling the loader
+	// Cardinality: 1
+	CacheCoalescedLoadsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "cache_coalesced_loads_total",
+			Help: "Total number of cache callers coalesced into an in-flight load",
+		},
+	)
+
+	// Price alerts fired by rules, rules added at runtime share the "dynamic" label
+	// Cardinality: number of configured alert rules + 1
+	AlertsFiredTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "alerts_fired_total",
+			Help: "Total number of price alerts fired",
+		},
+		[]string{"rule"},
+	)
+
+	// Alert webhook deliveries by result
+	// Cardinality: 3 (success, failed, dropped)
+	AlertDeliveriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "alert_deliveries_total",
+			Help: "Total number of alert webhook deliveries by status",
+		},
+		[]string{"status"},
+	)
+
+	// Market chart requests served from local price history instead of upstream
+	// Cardinality: 1
+	MarketChartHistoryServedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "market_chart_history_served_total",
+			Help: "Total number of market chart requests served from local price history",
+		},
+	)
+
+	// Version of the active config, incremented on every applied reload
+	// Cardinality: 1
+	ConfigVersionGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: MetricsPrefix + "config_version",
+			Help: "Version of the active config, incremented on every applied reload",
+		},
+	)
+
+	// Config reloads by result
+	// Cardinality: 2 (applied, rejected)
+	ConfigReloadsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "config_reloads_total",
+			Help: "Total number of config reloads by status",
+		},
+		[]string{"status"},
+	)
+
+	// Client requests by consumer and quota result
+	// Cardinality: consumers * 2 (allowed, rate_limited)
+	ConsumerRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "consumer_requests_total",
+			Help: "Total number of client requests by consumer and status",
+		},
+		[]string{"consumer", "status"},
+	)
+
+	// Rejected client authentications
+	// Cardinality: 2 (missing, invalid)
+	ClientAuthFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricsPrefix + "client_auth_failures_total",
+			Help: "Total number of requests rejected by client authentication by reason",
+		},
+		[]string{"reason"},
+	)
+
 	// Rate limit hits counter
 	// Cardinality: ~5 (number of services)
 	RateLimitCounter = promauto.NewCounterVec(
@@ -147,6 +239,47 @@ func RecordTokensByPlatform(tokensByPlatform map[string]int) {
 	}
 }
 
+// RecordCacheLoad records a loader call made by cache
+func RecordCacheLoad() {
+	CacheLoadsTotal.Inc()
+}
+
+// RecordCacheLoadCoalesced records a cache caller served by an in-flight load
+func RecordCacheLoadCoalesced() {
+	CacheCoalescedLoadsTotal.Inc()
+}
+
+// RecordAlertFired records a price alert fired by rule
+func RecordAlertFired(rule string) {
+	AlertsFiredTotal.WithLabelValues(rule).Inc()
+}
+
+// RecordAlertDelivery records an alert webhook delivery with its status
+func RecordAlertDelivery(status string) {
+	AlertDeliveriesTotal.WithLabelValues(status).Inc()
+}
+
+// RecordMarketChartHistoryServed records a market chart request served from local price history
+func RecordMarketChartHistoryServed() {
+	MarketChartHistoryServedTotal.Inc()
+}
+
+// RecordConfigReload records a config reload with its status and the active config version
+func RecordConfigReload(status string, version int) {
+	ConfigReloadsTotal.WithLabelValues(status).Inc()
+	ConfigVersionGauge.Set(float64(version))
+}
+
+// RecordConsumerRequest records a client request of a consumer with its quota status
+func RecordConsumerRequest(consumer, status string) {
+	ConsumerRequestsTotal.WithLabelValues(consumer, status).Inc()
+}
+
+// RecordClientAuthFailure records a request rejected by client authentication
+func RecordClientAuthFailure(reason string) {
+	ClientAuthFailuresTotal.WithLabelValues(reason).Inc()
+}
+
 // MetricsWriter provides a unified interface for recording service metrics
 type MetricsWriter struct {
 	serviceName string
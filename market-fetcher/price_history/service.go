@@ -0,0 +1,159 @@
+package price_history
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/status-im/market-proxy/config"
+	"github.com/status-im/market-proxy/metrics"
+	"github.com/status-im/market-proxy/scheduler"
+	"github.com/status-im/market-proxy/snapshot"
+)
+
+const (
+	snapshotName  = "price_history"
+	pruneInterval = 10 * time.Minute
+)
+
+// Service records prices updates into downsampled series and serves them as market charts
+type Service struct {
+	config         *config.PriceHistoryConfig
+	store          *Store
+	metricsWriter  *metrics.MetricsWriter
+	snapshotStore  *snapshot.Store
+	snapshotSaver  *snapshot.Saver
+	pruneScheduler *scheduler.Scheduler
+}
+
+// NewService creates a new price history service with 1m, 5m and 1h resolutions
+func NewService(cfg *config.PriceHistoryConfig) *Service {
+	resolutions := []Resolution{
+		{Step: time.Minute, Retention: cfg.Retention.GetMinute()},
+		{Step: 5 * time.Minute, Retention: cfg.Retention.GetFiveMinute()},
+		{Step: time.Hour, Retention: cfg.Retention.GetHour()},
+	}
+
+	return &Service{
+		config:        cfg,
+		store:         NewStore(resolutions),
+		metricsWriter: metrics.NewMetricsWriter(metrics.ServicePriceHistory),
+	}
+}
+
+// SetSnapshotStore enables persistence of recorded history across restarts
+func (s *Service) SetSnapshotStore(store *snapshot.Store) {
+	s.snapshotStore = store
+}
+
+// Start restores history from snapshot and starts periodic pruning of expired points
+func (s *Service) Start(ctx context.Context) error {
+	s.restoreSnapshot()
+
+	s.snapshotSaver = snapshot.NewSaver(s.snapshotStore, snapshotName, func() (interface{}, error) {
+		return s.store.snapshot(), nil
+	})
+	s.snapshotSaver.Start(ctx)
+
+	s.pruneScheduler = scheduler.New(pruneInterval, func(ctx context.Context) {
+		s.store.Prune(time.Now())
+		s.metricsWriter.RecordCacheSize(s.store.Len())
+	})
+	s.pruneScheduler.Start(ctx, false)
+
+	return nil
+}
+
+// Stop stops pruning and saves the final snapshot
+func (s *Service) Stop() {
+	if s.pruneScheduler != nil {
+		s.pruneScheduler.Stop()
+		s.pruneScheduler = nil
+	}
+	if s.snapshotSaver != nil {
+		s.snapshotSaver.Stop()
+		s.snapshotSaver = nil
+	}
+}
+
+// restoreSnapshot loads history saved by a previous run
+func (s *Service) restoreSnapshot() {
+	var state storeSnapshot
+	found, err := s.snapshotStore.Load(snapshotName, &state)
+	if err != nil {
+		log.Printf("Failed to restore price history snapshot: %v", err)
+		return
+	}
+	if !found {
+		return
+	}
+
+	s.store.restore(state)
+	s.store.Prune(time.Now())
+	log.Printf("Restored price history of %d series from snapshot", s.store.Len())
+}
+
+// RecordPrices records prices in configured currencies. The sample time is taken from
+// last_updated_at, so data which was already recorded (e.g. restored from snapshot) is ignored.
+func (s *Service) RecordPrices(pricesData map[string][]byte) {
+	now := time.Now().UnixMilli()
+	currencies := s.config.GetCurrencies()
+
+	for tokenID, data := range pricesData {
+		var fields map[string]interface{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			continue
+		}
+
+		timestamp := now
+		if updatedAt, ok := fields["last_updated_at"].(float64); ok && updatedAt > 0 {
+			timestamp = int64(updatedAt) * 1000
+		}
+
+		for _, currency := range currencies {
+			price, ok := fields[currency].(float64)
+			if !ok {
+				continue
+			}
+			marketCap, _ := fields[currency+"_market_cap"].(float64)
+			volume, _ := fields[currency+"_24h_vol"].(float64)
+
+			s.store.Append(tokenID, currency, Point{
+				Timestamp: timestamp,
+				Price:     price,
+				MarketCap: marketCap,
+				Volume:    volume,
+			})
+		}
+	}
+}
+
+// MarketChart returns recorded points of the last duration in CoinGecko market chart format
+func (s *Service) MarketChart(tokenID, currency string, duration, step time.Duration) (map[string][][2]float64, bool) {
+	points, ok := s.store.Range(tokenID, currency, step, time.Now().Add(-duration))
+	if !ok {
+		return nil, false
+	}
+
+	prices := make([][2]float64, len(points))
+	marketCaps := make([][2]float64, len(points))
+	totalVolumes := make([][2]float64, len(points))
+	for i, point := range points {
+		timestamp := float64(point.Timestamp)
+		prices[i] = [2]float64{timestamp, point.Price}
+		marketCaps[i] = [2]float64{timestamp, point.MarketCap}
+		totalVolumes[i] = [2]float64{timestamp, point.Volume}
+	}
+
+	return map[string][][2]float64{
+		"prices":        prices,
+		"market_caps":   marketCaps,
+		"total_volumes": totalVolumes,
+	}, true
+}
+
+// Healthy returns true as history is recorded locally
+func (s *Service) Healthy() bool {
+	return true
+}
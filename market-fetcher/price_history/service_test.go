@@ -0,0 +1,47 @@
+package price_history
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/config"
+)
+
+func TestService_RecordPrices(t *testing.T) {
+	service := NewService(&config.PriceHistoryConfig{Enabled: true, Currencies: []string{"usd", "eur"}})
+	updatedAt := time.Now().Add(-time.Minute).Unix()
+
+	service.RecordPrices(map[string][]byte{
+		"bitcoin":  []byte(fmt.Sprintf(`{"usd": 100, "usd_market_cap": 1000, "usd_24h_vol": 10, "eur": 90, "last_updated_at": %d}`, updatedAt)),
+		"ethereum": []byte(`{"btc": 0.05}`),
+		"broken":   []byte(`not json`),
+	})
+
+	assert.Equal(t, 2, service.store.Len(), "bitcoin is recorded in usd and eur")
+
+	chart, ok := service.MarketChart("bitcoin", "usd", 2*time.Minute, 5*time.Minute)
+	require.True(t, ok)
+	timestamp := float64(updatedAt * 1000)
+	assert.Equal(t, [][2]float64{{timestamp, 100}}, chart["prices"])
+	assert.Equal(t, [][2]float64{{timestamp, 1000}}, chart["market_caps"])
+	assert.Equal(t, [][2]float64{{timestamp, 10}}, chart["total_volumes"])
+
+	chart, ok = service.MarketChart("bitcoin", "eur", 2*time.Minute, 5*time.Minute)
+	require.True(t, ok)
+	assert.Equal(t, [][2]float64{{timestamp, 0}}, chart["market_caps"])
+}
+
+func TestService_MarketChart_NotCovered(t *testing.T) {
+	service := NewService(&config.PriceHistoryConfig{Enabled: true})
+
+	service.RecordPrices(map[string][]byte{
+		"bitcoin": []byte(`{"usd": 100}`),
+	})
+
+	_, ok := service.MarketChart("bitcoin", "usd", 24*time.Hour, 5*time.Minute)
+	assert.False(t, ok)
+}
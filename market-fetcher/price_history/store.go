@@ -0,0 +1,224 @@
+package price_history
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Point is the last sample recorded within a bucket
+type Point struct {
+	Timestamp int64 // Unix milliseconds of the sample
+	Price     float64
+	MarketCap float64
+	Volume    float64
+}
+
+// MarshalJSON encodes point as a compact [timestamp, price, market_cap, volume] array
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]float64{float64(p.Timestamp), p.Price, p.MarketCap, p.Volume})
+}
+
+// UnmarshalJSON decodes point encoded by MarshalJSON
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var values [4]float64
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*p = Point{Timestamp: int64(values[0]), Price: values[1], MarketCap: values[2], Volume: values[3]}
+	return nil
+}
+
+// Resolution defines bucket size and how long buckets are kept
+type Resolution struct {
+	Step      time.Duration
+	Retention time.Duration
+}
+
+// Name returns the resolution name used in snapshots, e.g. "5m"
+func (r Resolution) Name() string {
+	switch {
+	case r.Step%time.Hour == 0:
+		return fmt.Sprintf("%dh", r.Step/time.Hour)
+	case r.Step%time.Minute == 0:
+		return fmt.Sprintf("%dm", r.Step/time.Minute)
+	default:
+		return r.Step.String()
+	}
+}
+
+// bucket returns the bucket index of a timestamp in milliseconds
+func (r Resolution) bucket(timestamp int64) int64 {
+	return timestamp / r.Step.Milliseconds()
+}
+
+// Store keeps downsampled price series in memory, one series per token and currency
+// for every resolution. Within a bucket only the last sample is kept.
+type Store struct {
+	resolutions []Resolution
+	mu          sync.RWMutex
+	series      map[string][][]Point // series key -> points per resolution, ascending by time
+}
+
+// NewStore creates an empty store with the given resolutions
+func NewStore(resolutions []Resolution) *Store {
+	return &Store{
+		resolutions: resolutions,
+		series:      make(map[string][][]Point),
+	}
+}
+
+// seriesKey returns the key of a token series in the given currency
+func seriesKey(tokenID, currency string) string {
+	return tokenID + ":" + currency
+}
+
+// Append records a sample in every resolution. Samples older than the last recorded one are ignored.
+func (s *Store) Append(tokenID, currency string, point Point) {
+	key := seriesKey(tokenID, currency)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series, ok := s.series[key]
+	if !ok {
+		series = make([][]Point, len(s.resolutions))
+		s.series[key] = series
+	}
+
+	for i, resolution := range s.resolutions {
+		series[i] = appendPoint(series[i], resolution, point)
+	}
+}
+
+// appendPoint adds the point to the series, replacing the last point of the same bucket,
+// and drops points which are out of retention
+func appendPoint(points []Point, resolution Resolution, point Point) []Point {
+	if n := len(points); n > 0 {
+		last := points[n-1]
+		if point.Timestamp < last.Timestamp {
+			return points
+		}
+		if resolution.bucket(last.Timestamp) == resolution.bucket(point.Timestamp) {
+			points[n-1] = point
+			return points
+		}
+	}
+
+	points = append(points, point)
+	return trimPoints(points, point.Timestamp-resolution.Retention.Milliseconds())
+}
+
+// trimPoints drops points recorded before cutoff (Unix milliseconds)
+func trimPoints(points []Point, cutoff int64) []Point {
+	index := sort.Search(len(points), func(i int) bool {
+		return points[i].Timestamp >= cutoff
+	})
+	if index == 0 {
+		return points
+	}
+	return append([]Point(nil), points[index:]...)
+}
+
+// Range returns points of the resolution with the given step recorded since `from`.
+// ok is false if the step is not stored or the series does not cover the whole range.
+func (s *Store) Range(tokenID, currency string, step time.Duration, from time.Time) (points []Point, ok bool) {
+	index := s.resolutionIndex(step)
+	if index < 0 {
+		return nil, false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	series, exists := s.series[seriesKey(tokenID, currency)]
+	if !exists || len(series[index]) == 0 {
+		return nil, false
+	}
+
+	stored := series[index]
+	fromMs := from.UnixMilli()
+	// History must reach the first requested bucket, one bucket of slack allows for retention trimming
+	if s.resolutions[index].bucket(stored[0].Timestamp) > s.resolutions[index].bucket(fromMs)+1 {
+		return nil, false
+	}
+
+	start := sort.Search(len(stored), func(i int) bool {
+		return stored[i].Timestamp >= fromMs
+	})
+	return append([]Point(nil), stored[start:]...), true
+}
+
+// Prune drops points out of retention at the given time and removes empty series
+func (s *Store) Prune(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, series := range s.series {
+		empty := true
+		for i, resolution := range s.resolutions {
+			series[i] = trimPoints(series[i], now.Add(-resolution.Retention).UnixMilli())
+			if len(series[i]) > 0 {
+				empty = false
+			}
+		}
+		if empty {
+			delete(s.series, key)
+		}
+	}
+}
+
+// Len returns the number of stored series
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.series)
+}
+
+// resolutionIndex returns index of the resolution with the given step or -1
+func (s *Store) resolutionIndex(step time.Duration) int {
+	for i, resolution := range s.resolutions {
+		if resolution.Step == step {
+			return i
+		}
+	}
+	return -1
+}
+
+// storeSnapshot is a persisted form of the store: series key -> resolution name -> points
+type storeSnapshot map[string]map[string][]Point
+
+// snapshot returns a copy of all series
+func (s *Store) snapshot() storeSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(storeSnapshot, len(s.series))
+	for key, series := range s.series {
+		byResolution := make(map[string][]Point, len(s.resolutions))
+		for i, resolution := range s.resolutions {
+			if len(series[i]) > 0 {
+				byResolution[resolution.Name()] = append([]Point(nil), series[i]...)
+			}
+		}
+		result[key] = byResolution
+	}
+	return result
+}
+
+// restore replaces series with the snapshot, resolutions which are not configured anymore are skipped
+func (s *Store) restore(state storeSnapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.series = make(map[string][][]Point, len(state))
+	for key, byResolution := range state {
+		series := make([][]Point, len(s.resolutions))
+		for i, resolution := range s.resolutions {
+			series[i] = byResolution[resolution.Name()]
+		}
+		s.series[key] = series
+	}
+}
@@ -0,0 +1,122 @@
+package price_history
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestStore() *Store {
+	return NewStore([]Resolution{
+		{Step: time.Minute, Retention: time.Hour},
+		{Step: 5 * time.Minute, Retention: 24 * time.Hour},
+	})
+}
+
+func TestStore_Append_KeepsLastSampleInBucket(t *testing.T) {
+	store := createTestStore()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	store.Append("bitcoin", "usd", Point{Timestamp: start.UnixMilli(), Price: 1})
+	store.Append("bitcoin", "usd", Point{Timestamp: start.Add(30 * time.Second).UnixMilli(), Price: 2})
+	store.Append("bitcoin", "usd", Point{Timestamp: start.Add(90 * time.Second).UnixMilli(), Price: 3})
+
+	minutePoints, ok := store.Range("bitcoin", "usd", time.Minute, start)
+	require.True(t, ok)
+	require.Len(t, minutePoints, 2)
+	assert.Equal(t, 2.0, minutePoints[0].Price)
+	assert.Equal(t, 3.0, minutePoints[1].Price)
+
+	fiveMinutePoints, ok := store.Range("bitcoin", "usd", 5*time.Minute, start)
+	require.True(t, ok)
+	require.Len(t, fiveMinutePoints, 1)
+	assert.Equal(t, 3.0, fiveMinutePoints[0].Price)
+}
+
+func TestStore_Append_IgnoresOlderSamples(t *testing.T) {
+	store := createTestStore()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	store.Append("bitcoin", "usd", Point{Timestamp: start.Add(time.Minute).UnixMilli(), Price: 2})
+	store.Append("bitcoin", "usd", Point{Timestamp: start.UnixMilli(), Price: 1})
+
+	points, ok := store.Range("bitcoin", "usd", time.Minute, start.Add(time.Minute))
+	require.True(t, ok)
+	require.Len(t, points, 1)
+	assert.Equal(t, 2.0, points[0].Price)
+}
+
+func TestStore_Append_TrimsRetention(t *testing.T) {
+	store := createTestStore()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i <= 120; i++ {
+		store.Append("bitcoin", "usd", Point{Timestamp: start.Add(time.Duration(i) * time.Minute).UnixMilli(), Price: float64(i)})
+	}
+
+	// Only the last hour of minute buckets is kept
+	_, ok := store.Range("bitcoin", "usd", time.Minute, start)
+	assert.False(t, ok)
+
+	points, ok := store.Range("bitcoin", "usd", time.Minute, start.Add(time.Hour))
+	require.True(t, ok)
+	assert.Len(t, points, 61)
+	assert.Equal(t, 60.0, points[0].Price)
+}
+
+func TestStore_Range_RequiresCoverage(t *testing.T) {
+	store := createTestStore()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.Append("bitcoin", "usd", Point{Timestamp: now.Add(-time.Hour).UnixMilli(), Price: 1})
+	store.Append("bitcoin", "usd", Point{Timestamp: now.UnixMilli(), Price: 2})
+
+	_, ok := store.Range("bitcoin", "usd", 5*time.Minute, now.Add(-2*time.Hour))
+	assert.False(t, ok, "history is shorter than requested range")
+
+	points, ok := store.Range("bitcoin", "usd", 5*time.Minute, now.Add(-time.Hour))
+	require.True(t, ok)
+	assert.Len(t, points, 2)
+
+	_, ok = store.Range("bitcoin", "usd", time.Hour, now.Add(-time.Hour))
+	assert.False(t, ok, "resolution is not stored")
+
+	_, ok = store.Range("ethereum", "usd", 5*time.Minute, now.Add(-time.Hour))
+	assert.False(t, ok, "series does not exist")
+}
+
+func TestStore_Prune(t *testing.T) {
+	store := createTestStore()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.Append("bitcoin", "usd", Point{Timestamp: now.Add(-2 * time.Hour).UnixMilli(), Price: 1})
+	store.Append("ethereum", "usd", Point{Timestamp: now.Add(-48 * time.Hour).UnixMilli(), Price: 1})
+
+	store.Prune(now)
+
+	assert.Equal(t, 1, store.Len())
+	_, ok := store.Range("bitcoin", "usd", time.Minute, now.Add(-3*time.Hour))
+	assert.False(t, ok, "minute buckets are out of retention")
+	_, ok = store.Range("bitcoin", "usd", 5*time.Minute, now.Add(-2*time.Hour))
+	assert.True(t, ok)
+}
+
+func TestStore_SnapshotRestore(t *testing.T) {
+	store := createTestStore()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.Append("bitcoin", "usd", Point{Timestamp: now.UnixMilli(), Price: 1, MarketCap: 2, Volume: 3})
+
+	data, err := json.Marshal(store.snapshot())
+	require.NoError(t, err)
+
+	var state storeSnapshot
+	require.NoError(t, json.Unmarshal(data, &state))
+
+	restored := createTestStore()
+	restored.restore(state)
+
+	points, ok := restored.Range("bitcoin", "usd", 5*time.Minute, now)
+	require.True(t, ok)
+	assert.Equal(t, []Point{{Timestamp: now.UnixMilli(), Price: 1, MarketCap: 2, Volume: 3}}, points)
+}
@@ -0,0 +1,116 @@
+package response
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	EncodingZstd   = "zstd"
+	EncodingBrotli = "br"
+	EncodingGzip   = "gzip"
+
+	// MinCompressSize is the smallest body worth compressing
+	MinCompressSize = 1024
+
+	// brotliLevel trades ratio for speed, dynamic responses are compressed on every request
+	brotliLevel = 5
+)
+
+// Encodings are supported content encodings in server preference order,
+// used when the client accepts several with the same weight
+var Encodings = []string{EncodingZstd, EncodingBrotli, EncodingGzip}
+
+var (
+	gzipWriters = sync.Pool{New: func() interface{} {
+		writer, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+		return writer
+	}}
+	brotliWriters = sync.Pool{New: func() interface{} {
+		return brotli.NewWriterLevel(nil, brotliLevel)
+	}}
+
+	// zstdEncoder is safe for concurrent EncodeAll calls
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+)
+
+// NegotiateEncoding returns the supported encoding with the highest weight in Accept-Encoding header,
+// empty string means the body is sent as is
+func NegotiateEncoding(acceptEncoding string) string {
+	if acceptEncoding == "" {
+		return ""
+	}
+
+	weights := make(map[string]float64)
+	wildcard := -1.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		weight := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+
+		if name == "*" {
+			wildcard = weight
+		} else {
+			weights[name] = weight
+		}
+	}
+
+	best, bestWeight := "", 0.0
+	for _, encoding := range Encodings {
+		weight, exists := weights[encoding]
+		if !exists {
+			weight = wildcard
+		}
+		if weight > bestWeight {
+			best, bestWeight = encoding, weight
+		}
+	}
+	return best
+}
+
+// Compress compresses body with the given encoding
+func Compress(encoding string, body []byte) ([]byte, error) {
+	switch encoding {
+	case EncodingZstd:
+		return zstdEncoder.EncodeAll(body, make([]byte, 0, len(body)/4)), nil
+	case EncodingBrotli:
+		var buf bytes.Buffer
+		writer := brotliWriters.Get().(*brotli.Writer)
+		defer brotliWriters.Put(writer)
+		writer.Reset(&buf)
+		if _, err := writer.Write(body); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case EncodingGzip:
+		var buf bytes.Buffer
+		writer := gzipWriters.Get().(*gzip.Writer)
+		defer gzipWriters.Put(writer)
+		writer.Reset(&buf)
+		if _, err := writer.Write(body); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return body, nil
+}
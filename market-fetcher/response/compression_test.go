@@ -0,0 +1,75 @@
+package response
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		expected       string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", EncodingGzip},
+		{"gzip, deflate, br", EncodingBrotli},
+		{"gzip, deflate, br, zstd", EncodingZstd},
+		{"zstd;q=0.5, br;q=0.8, gzip", EncodingGzip},
+		{"GZIP", EncodingGzip},
+		{"*", EncodingZstd},
+		{"*;q=0.1, br;q=0", EncodingZstd},
+		{"br;q=0, zstd;q=0, gzip;q=0", ""},
+		{"gzip;q=invalid", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NegotiateEncoding(tt.acceptEncoding))
+		})
+	}
+}
+
+func decompressBody(t *testing.T, encoding string, body []byte) []byte {
+	var reader io.Reader
+	switch encoding {
+	case EncodingGzip:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		reader = gzipReader
+	case EncodingBrotli:
+		reader = brotli.NewReader(bytes.NewReader(body))
+	case EncodingZstd:
+		zstdReader, err := zstd.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		defer zstdReader.Close()
+		reader = zstdReader
+	default:
+		return body
+	}
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return data
+}
+
+func TestCompress(t *testing.T) {
+	body := []byte(strings.Repeat(`{"id":"bitcoin","symbol":"btc","name":"Bitcoin"},`, 100))
+
+	for _, encoding := range Encodings {
+		t.Run(encoding, func(t *testing.T) {
+			compressed, err := Compress(encoding, body)
+			require.NoError(t, err)
+			assert.Less(t, len(compressed), len(body))
+			assert.Equal(t, body, decompressBody(t, encoding, compressed))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package response
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Snapshot is an immutable serialized JSON response built once per data update:
+// the body, its compressed copies and ETag are served as is by API handlers
+type Snapshot struct {
+	body       []byte
+	etag       string
+	compressed map[string][]byte // encoding -> compressed body, empty for small bodies
+	updatedAt  time.Time
+}
+
+// NewSnapshot serializes data to JSON and pre-compresses it with all supported encodings
+func NewSnapshot(data interface{}, updatedAt time.Time) (*Snapshot, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding snapshot: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		body:       body,
+		etag:       ETag(body),
+		compressed: make(map[string][]byte, len(Encodings)),
+		updatedAt:  updatedAt,
+	}
+
+	if len(body) >= MinCompressSize {
+		for _, encoding := range Encodings {
+			compressed, err := Compress(encoding, body)
+			if err != nil {
+				return nil, fmt.Errorf("error compressing snapshot with %s: %w", encoding, err)
+			}
+			snapshot.compressed[encoding] = compressed
+		}
+	}
+
+	return snapshot, nil
+}
+
+// ETag returns MD5 hash of the body, without quotes
+func ETag(body []byte) string {
+	hash := md5.Sum(body)
+	return hex.EncodeToString(hash[:])
+}
+
+// Body returns serialized JSON, must not be modified
+func (s *Snapshot) Body() []byte {
+	return s.body
+}
+
+// ETag returns MD5 hash of the uncompressed body, without quotes
+func (s *Snapshot) ETag() string {
+	return s.etag
+}
+
+// Compressed returns the body compressed with the encoding, must not be modified
+func (s *Snapshot) Compressed(encoding string) ([]byte, bool) {
+	compressed, exists := s.compressed[encoding]
+	return compressed, exists
+}
+
+// UpdatedAt returns the time of the data update the snapshot was built from
+func (s *Snapshot) UpdatedAt() time.Time {
+	return s.updatedAt
+}
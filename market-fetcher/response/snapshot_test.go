@@ -0,0 +1,44 @@
+package response
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	updatedAt := time.Now()
+	data := map[string]string{"name": strings.Repeat("bitcoin", 200)}
+
+	snapshot, err := NewSnapshot(data, updatedAt)
+	require.NoError(t, err)
+
+	expected, _ := json.Marshal(data)
+	assert.Equal(t, expected, snapshot.Body())
+	assert.Equal(t, ETag(expected), snapshot.ETag())
+	assert.Equal(t, updatedAt, snapshot.UpdatedAt())
+
+	for _, encoding := range Encodings {
+		compressed, exists := snapshot.Compressed(encoding)
+		require.True(t, exists, encoding)
+		assert.Equal(t, expected, decompressBody(t, encoding, compressed))
+	}
+}
+
+func TestNewSnapshot_SmallBody(t *testing.T) {
+	snapshot, err := NewSnapshot([]string{}, time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, "[]", string(snapshot.Body()))
+	_, exists := snapshot.Compressed(EncodingGzip)
+	assert.False(t, exists)
+}
+
+func TestNewSnapshot_InvalidData(t *testing.T) {
+	_, err := NewSnapshot(map[string]interface{}{"invalid": make(chan int)}, time.Now())
+	assert.Error(t, err)
+}
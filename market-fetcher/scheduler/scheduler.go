@@ -15,13 +15,15 @@ type Scheduler struct {
 	running     bool
 	cancel      context.CancelFunc
 	taskRunning sync.Mutex // Mutex to prevent concurrent task executions
+	intervalCh  chan time.Duration
 }
 
 // New creates a new Scheduler instance
 func New(interval time.Duration, task func(context.Context)) *Scheduler {
 	return &Scheduler{
-		interval: interval,
-		task:     task,
+		interval:   interval,
+		task:       task,
+		intervalCh: make(chan time.Duration, 1),
 	}
 }
 
@@ -37,6 +39,7 @@ func (s *Scheduler) Start(ctx context.Context, firstRunImmediately bool) {
 	// Create a new context with cancellation
 	ctx, s.cancel = context.WithCancel(ctx)
 	s.running = true
+	interval := s.interval
 
 	s.wg.Add(1)
 	go func() {
@@ -47,13 +50,15 @@ func (s *Scheduler) Start(ctx context.Context, firstRunImmediately bool) {
 			s.runTaskIfNotRunning(ctx)
 		}
 
-		ticker := time.NewTicker(s.interval)
+		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
 
 		for {
 			select {
 			case <-ticker.C:
 				s.runTaskIfNotRunning(ctx)
+			case newInterval := <-s.intervalCh:
+				ticker.Reset(newInterval)
 			case <-ctx.Done():
 				return
 			}
@@ -88,6 +93,28 @@ func (s *Scheduler) Stop() {
 	s.running = false
 }
 
+// SetInterval changes the interval, a running scheduler restarts its ticker with the new interval
+func (s *Scheduler) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.interval == interval {
+		return
+	}
+	s.interval = interval
+
+	// Replace a pending interval change which has not been applied yet
+	select {
+	case <-s.intervalCh:
+	default:
+	}
+	s.intervalCh <- interval
+}
+
 // IsRunning returns true if the task is currently running
 func (s *Scheduler) IsRunning() bool {
 	s.mu.Lock()
@@ -272,3 +272,24 @@ func TestPeriodicTask_SkipsIfBusy(t *testing.T) {
 	assert.GreaterOrEqual(t, finalCount, int32(1))
 	assert.LessOrEqual(t, finalCount, int32(3))
 }
+
+func TestPeriodicTask_SetInterval(t *testing.T) {
+	var counter int32
+	pt := New(time.Hour, func(ctx context.Context) {
+		atomic.AddInt32(&counter, 1)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pt.Start(ctx, false)
+	defer pt.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter))
+
+	// Running scheduler switches to the new interval without restart
+	pt.SetInterval(20 * time.Millisecond)
+	time.Sleep(110 * time.Millisecond)
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&counter), int32(3))
+}
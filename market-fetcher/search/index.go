@@ -0,0 +1,208 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// Match scores, higher is better. Market cap rank adds up to maxRankBoost on top of them.
+const (
+	scoreExactSymbol  = 100
+	scoreExactIDName  = 90
+	scorePrefixSymbol = 70
+	scorePrefixIDName = 60
+	scorePrefixWord   = 50
+	scoreSubstring    = 30
+	scoreFuzzy        = 10
+
+	maxRankBoost = 40.0
+
+	// minFuzzyQueryLength is the minimal query length for typo-tolerant matching
+	minFuzzyQueryLength = 4
+)
+
+// Coin is a search result entry
+type Coin struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	MarketCapRank *int   `json:"market_cap_rank"`
+}
+
+// entry is an indexed token with precomputed lowercase fields
+type entry struct {
+	token  interfaces.Token
+	id     string
+	symbol string
+	name   string
+	words  []string
+}
+
+// Index is an immutable search index over the coins list
+type Index struct {
+	entries []entry
+}
+
+// NewIndex builds an index over tokens, tokens without ID are skipped
+func NewIndex(tokens []interfaces.Token) *Index {
+	entries := make([]entry, 0, len(tokens))
+	for _, token := range tokens {
+		if token.ID == "" {
+			continue
+		}
+		name := strings.ToLower(token.Name)
+		entries = append(entries, entry{
+			token:  token,
+			id:     strings.ToLower(token.ID),
+			symbol: strings.ToLower(token.Symbol),
+			name:   name,
+			words:  strings.FieldsFunc(name, isWordSeparator),
+		})
+	}
+	return &Index{entries: entries}
+}
+
+// Len returns the number of indexed coins
+func (idx *Index) Len() int {
+	return len(idx.entries)
+}
+
+// Search returns up to limit coins matching the query ordered by match score weighted by market cap rank.
+// ranks maps coin ID to its market cap rank (1-based), unranked coins get no boost.
+func (idx *Index) Search(query string, ranks map[string]int, limit int) []Coin {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" || limit <= 0 {
+		return []Coin{}
+	}
+
+	type scored struct {
+		entry *entry
+		rank  int
+		score float64
+	}
+
+	var matches []scored
+	for i := range idx.entries {
+		e := &idx.entries[i]
+		score := matchScore(e, query)
+		if score == 0 {
+			continue
+		}
+		rank := ranks[e.token.ID]
+		matches = append(matches, scored{entry: e, rank: rank, score: float64(score) + rankBoost(rank)})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].entry.id < matches[j].entry.id
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	result := make([]Coin, len(matches))
+	for i, match := range matches {
+		result[i] = Coin{
+			ID:     match.entry.token.ID,
+			Name:   match.entry.token.Name,
+			Symbol: match.entry.token.Symbol,
+		}
+		if match.rank > 0 {
+			rank := match.rank
+			result[i].MarketCapRank = &rank
+		}
+	}
+	return result
+}
+
+// matchScore returns the best match score of the query against symbol, name and ID, 0 if nothing matches
+func matchScore(e *entry, query string) int {
+	switch {
+	case e.symbol == query:
+		return scoreExactSymbol
+	case e.id == query || e.name == query:
+		return scoreExactIDName
+	case strings.HasPrefix(e.symbol, query):
+		return scorePrefixSymbol
+	case strings.HasPrefix(e.id, query) || strings.HasPrefix(e.name, query):
+		return scorePrefixIDName
+	}
+
+	for _, word := range e.words {
+		if strings.HasPrefix(word, query) {
+			return scorePrefixWord
+		}
+	}
+
+	if strings.Contains(e.id, query) || strings.Contains(e.name, query) {
+		return scoreSubstring
+	}
+
+	if len(query) >= minFuzzyQueryLength && (fuzzyMatch(query, e.id) || fuzzyMatch(query, e.name)) {
+		return scoreFuzzy
+	}
+
+	return 0
+}
+
+// rankBoost returns the score boost of a market cap rank: 40 for rank 1, 20 for rank 10, 10 for rank 1000
+func rankBoost(rank int) float64 {
+	if rank <= 0 {
+		return 0
+	}
+	return maxRankBoost / (1 + math.Log10(float64(rank)))
+}
+
+// fuzzyMatch reports whether the query is within one edit (insertion, deletion or substitution)
+// of the whole field or of its prefix
+func fuzzyMatch(query, field string) bool {
+	if withinOneEdit(query, field) {
+		return true
+	}
+	for _, length := range []int{len(query) - 1, len(query), len(query) + 1} {
+		if length > 0 && length < len(field) && withinOneEdit(query, field[:length]) {
+			return true
+		}
+	}
+	return false
+}
+
+// withinOneEdit reports whether a and b differ by at most one edit
+func withinOneEdit(a, b string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(b)-len(a) > 1 {
+		return false
+	}
+
+	i, j := 0, 0
+	edited := false
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			i++
+			j++
+			continue
+		}
+		if edited {
+			return false
+		}
+		edited = true
+		if len(a) == len(b) {
+			i++
+		}
+		j++
+	}
+	return !edited || (i == len(a) && j == len(b))
+}
+
+// isWordSeparator splits coin names into words
+func isWordSeparator(r rune) bool {
+	return r == ' ' || r == '-' || r == '_' || r == '.' || r == '(' || r == ')'
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+var testTokens = []interfaces.Token{
+	{ID: "ethereum", Symbol: "eth", Name: "Ethereum"},
+	{ID: "fake-eth", Symbol: "ETH", Name: "Fake ETH"},
+	{ID: "ethena", Symbol: "ena", Name: "Ethena"},
+	{ID: "usd-coin", Symbol: "usdc", Name: "USDC"},
+	{ID: "wrapped-bitcoin", Symbol: "wbtc", Name: "Wrapped Bitcoin"},
+	{ID: "", Symbol: "none", Name: "No ID"},
+}
+
+func resultIds(coins []Coin) []string {
+	ids := make([]string, len(coins))
+	for i, coin := range coins {
+		ids[i] = coin.ID
+	}
+	return ids
+}
+
+func TestIndex_Search(t *testing.T) {
+	index := NewIndex(testTokens)
+	require.Equal(t, 5, index.Len())
+
+	ranks := map[string]int{"ethereum": 2, "usd-coin": 7, "ethena": 40}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{"exact symbol ranked first", "ETH", []string{"ethereum", "fake-eth", "ethena"}},
+		{"prefix of name", "ethe", []string{"ethereum", "ethena"}},
+		{"prefix of name word", "bitc", []string{"wrapped-bitcoin"}},
+		{"substring of id", "coin", []string{"usd-coin", "wrapped-bitcoin"}},
+		{"typo", "etherium", []string{"ethereum"}},
+		{"no match", "solana", []string{}},
+		{"empty query", "  ", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resultIds(index.Search(tt.query, ranks, 10)))
+		})
+	}
+}
+
+func TestIndex_SearchRankAndLimit(t *testing.T) {
+	index := NewIndex(testTokens)
+
+	result := index.Search("eth", map[string]int{"ethereum": 2}, 1)
+	require.Len(t, result, 1)
+	assert.Equal(t, "ethereum", result[0].ID)
+	require.NotNil(t, result[0].MarketCapRank)
+	assert.Equal(t, 2, *result[0].MarketCapRank)
+
+	// Without ranks the exact symbol matches are ordered by ID
+	result = index.Search("eth", nil, 10)
+	assert.Equal(t, []string{"ethereum", "fake-eth", "ethena"}, resultIds(result))
+	assert.Nil(t, result[0].MarketCapRank)
+}
+
+func TestWithinOneEdit(t *testing.T) {
+	assert.True(t, withinOneEdit("abc", "abc"))
+	assert.True(t, withinOneEdit("abc", "abd"))
+	assert.True(t, withinOneEdit("abc", "abcd"))
+	assert.True(t, withinOneEdit("abd", "abcd"))
+	assert.False(t, withinOneEdit("abx", "abcd"))
+	assert.False(t, withinOneEdit("ab", "xbc"))
+	assert.False(t, withinOneEdit("abc", "xyz"))
+}
@@ -0,0 +1,106 @@
+package search
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/status-im/market-proxy/events"
+	"github.com/status-im/market-proxy/interfaces"
+)
+
+// ranksLimit is the number of top market IDs used as market cap ranks
+const ranksLimit = 100000
+
+// Service searches coins list locally, the index is rebuilt on every tokens update
+// and market cap ranks are refreshed on every markets update
+type Service struct {
+	tokensService             interfaces.ITokensService
+	marketsService            interfaces.IMarketsService
+	tokenUpdateSubscription   events.ISubscription
+	marketsUpdateSubscription events.ISubscription
+
+	mu    sync.RWMutex
+	index *Index
+	ranks map[string]int
+}
+
+// NewService creates a new search service
+func NewService(tokensService interfaces.ITokensService, marketsService interfaces.IMarketsService) *Service {
+	return &Service{
+		tokensService:  tokensService,
+		marketsService: marketsService,
+		index:          NewIndex(nil),
+		ranks:          make(map[string]int),
+	}
+}
+
+// Start subscribes to tokens and markets updates
+func (s *Service) Start(ctx context.Context) error {
+	if s.tokensService != nil {
+		s.tokenUpdateSubscription = s.tokensService.SubscribeOnTokensUpdate().
+			Watch(ctx, s.rebuildIndex, true)
+	}
+	if s.marketsService != nil {
+		s.marketsUpdateSubscription = s.marketsService.SubscribeTopMarketsUpdate().
+			Watch(ctx, s.refreshRanks, true)
+	}
+	return nil
+}
+
+// Stop cancels subscriptions
+func (s *Service) Stop() {
+	if s.tokenUpdateSubscription != nil {
+		s.tokenUpdateSubscription.Cancel()
+		s.tokenUpdateSubscription = nil
+	}
+	if s.marketsUpdateSubscription != nil {
+		s.marketsUpdateSubscription.Cancel()
+		s.marketsUpdateSubscription = nil
+	}
+}
+
+// rebuildIndex builds a new index from the current coins list
+func (s *Service) rebuildIndex() {
+	index := NewIndex(s.tokensService.GetTokens())
+
+	s.mu.Lock()
+	s.index = index
+	s.mu.Unlock()
+
+	log.Printf("Search index rebuilt - indexed coins: %d", index.Len())
+}
+
+// refreshRanks updates market cap ranks from the top market IDs
+func (s *Service) refreshRanks() {
+	topIds, err := s.marketsService.TopMarketIds(ranksLimit)
+	if err != nil {
+		log.Printf("Failed to get top market IDs for search ranks: %v", err)
+		return
+	}
+
+	ranks := make(map[string]int, len(topIds))
+	for i, id := range topIds {
+		ranks[id] = i + 1
+	}
+
+	s.mu.Lock()
+	s.ranks = ranks
+	s.mu.Unlock()
+}
+
+// Search returns up to limit coins matching the query
+func (s *Service) Search(query string, limit int) []Coin {
+	s.mu.RLock()
+	index, ranks := s.index, s.ranks
+	s.mu.RUnlock()
+
+	return index.Search(query, ranks, limit)
+}
+
+// Healthy returns true when the index has coins
+func (s *Service) Healthy() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.index.Len() > 0
+}
@@ -0,0 +1,48 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/status-im/market-proxy/events"
+	mock_interfaces "github.com/status-im/market-proxy/interfaces/mocks"
+)
+
+func TestService_RebuildsIndexAndRanks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTokens := mock_interfaces.NewMockITokensService(ctrl)
+	mockMarkets := mock_interfaces.NewMockIMarketsService(ctrl)
+	mockTokens.EXPECT().SubscribeOnTokensUpdate().Return(events.NewSubscriptionManager().Subscribe())
+	mockMarkets.EXPECT().SubscribeTopMarketsUpdate().Return(events.NewSubscriptionManager().Subscribe())
+
+	service := NewService(mockTokens, mockMarkets)
+	assert.False(t, service.Healthy())
+	assert.Empty(t, service.Search("eth", 10))
+
+	mockTokens.EXPECT().GetTokens().Return(testTokens)
+	mockMarkets.EXPECT().TopMarketIds(ranksLimit).Return([]string{"bitcoin", "ethereum"}, nil)
+
+	// Call the callbacks directly to avoid waiting for Watch goroutines
+	service.rebuildIndex()
+	service.refreshRanks()
+	assert.True(t, service.Healthy())
+
+	result := service.Search("eth", 1)
+	require.Len(t, result, 1)
+	assert.Equal(t, "ethereum", result[0].ID)
+	require.NotNil(t, result[0].MarketCapRank)
+	assert.Equal(t, 2, *result[0].MarketCapRank)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockTokens.EXPECT().GetTokens().Return(testTokens).AnyTimes()
+	mockMarkets.EXPECT().TopMarketIds(ranksLimit).Return([]string{"bitcoin", "ethereum"}, nil).AnyTimes()
+	require.NoError(t, service.Start(ctx))
+	service.Stop()
+}
@@ -0,0 +1,28 @@
+package snapshot
+
+import "time"
+
+// DefaultInterval is used when snapshots are enabled without explicit interval
+const DefaultInterval = time.Minute
+
+// Config represents warm-start snapshot configuration
+type Config struct {
+	// Dir is a directory where snapshots are stored, empty value disables snapshots
+	Dir string `yaml:"dir"`
+
+	// Interval between periodic snapshots
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Enabled returns true if snapshots are configured
+func (c Config) Enabled() bool {
+	return c.Dir != ""
+}
+
+// GetInterval returns snapshot interval with default fallback
+func (c Config) GetInterval() time.Duration {
+	if c.Interval <= 0 {
+		return DefaultInterval
+	}
+	return c.Interval
+}
@@ -0,0 +1,73 @@
+package snapshot
+
+import (
+	"context"
+	"log"
+
+	"github.com/status-im/market-proxy/scheduler"
+)
+
+// SnapshotFunc returns the current state to be saved
+type SnapshotFunc func() (interface{}, error)
+
+// Saver periodically saves a named snapshot and saves it once more on Stop
+type Saver struct {
+	store        *Store
+	name         string
+	snapshotFunc SnapshotFunc
+	scheduler    *scheduler.Scheduler
+}
+
+// NewSaver creates a new periodic snapshot saver
+func NewSaver(store *Store, name string, snapshotFunc SnapshotFunc) *Saver {
+	return &Saver{
+		store:        store,
+		name:         name,
+		snapshotFunc: snapshotFunc,
+	}
+}
+
+// Start begins periodic snapshots, does nothing if store is disabled
+func (s *Saver) Start(ctx context.Context) {
+	if s.store == nil {
+		return
+	}
+
+	s.scheduler = scheduler.New(s.store.Interval(), func(ctx context.Context) {
+		if err := s.Save(); err != nil {
+			log.Printf("Snapshot %s: %v", s.name, err)
+		}
+	})
+	s.scheduler.Start(ctx, false)
+}
+
+// Stop stops periodic snapshots and saves the final state
+func (s *Saver) Stop() {
+	if s.scheduler == nil {
+		return
+	}
+
+	s.scheduler.Stop()
+	s.scheduler = nil
+
+	if err := s.Save(); err != nil {
+		log.Printf("Snapshot %s: %v", s.name, err)
+	}
+}
+
+// Save takes a snapshot and writes it to the store
+func (s *Saver) Save() error {
+	if s.store == nil {
+		return nil
+	}
+
+	state, err := s.snapshotFunc()
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return nil
+	}
+
+	return s.store.Save(s.name, state)
+}
@@ -0,0 +1,113 @@
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const fileExtension = ".json.gz"
+
+// Store saves and loads named snapshots as gzipped JSON files
+// A nil Store is valid and means snapshots are disabled
+type Store struct {
+	dir      string
+	interval time.Duration
+}
+
+// NewStore creates a snapshot store, returns nil if snapshots are disabled
+func NewStore(cfg Config) *Store {
+	if !cfg.Enabled() {
+		return nil
+	}
+	return &Store{
+		dir:      cfg.Dir,
+		interval: cfg.GetInterval(),
+	}
+}
+
+// Interval returns the configured interval between periodic snapshots
+func (s *Store) Interval() time.Duration {
+	if s == nil {
+		return 0
+	}
+	return s.interval
+}
+
+// Save atomically writes the snapshot under the given name
+func (s *Store) Save(name string, v interface{}) error {
+	if s == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	// Remove temp file if anything goes wrong before rename
+	defer os.Remove(tmp.Name())
+
+	bw := bufio.NewWriter(tmp)
+	gz := gzip.NewWriter(bw)
+	if err := json.NewEncoder(gz).Encode(v); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to encode snapshot %s: %w", name, err)
+	}
+	if err := gz.Close(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to compress snapshot %s: %w", name, err)
+	}
+	if err := bw.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot %s: %w", name, err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(name)); err != nil {
+		return fmt.Errorf("failed to save snapshot %s: %w", name, err)
+	}
+	return nil
+}
+
+// Load reads the snapshot with the given name into v
+// Returns false if there is no snapshot yet
+func (s *Store) Load(name string, v interface{}) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+
+	f, err := os.Open(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to open snapshot %s: %w", name, err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return false, fmt.Errorf("failed to read snapshot %s: %w", name, err)
+	}
+	defer gz.Close()
+
+	if err := json.NewDecoder(gz).Decode(v); err != nil {
+		return false, fmt.Errorf("failed to decode snapshot %s: %w", name, err)
+	}
+	return true, nil
+}
+
+func (s *Store) path(name string) string {
+	return filepath.Join(s.dir, name+fileExtension)
+}
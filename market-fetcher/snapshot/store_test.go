@@ -0,0 +1,99 @@
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testState struct {
+	Items     map[string]int `json:"items"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+func TestNewStore_Disabled(t *testing.T) {
+	store := NewStore(Config{})
+	assert.Nil(t, store)
+
+	// Nil store is a no-op
+	assert.NoError(t, store.Save("test", testState{}))
+	found, err := store.Load("test", &testState{})
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_SaveLoad(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(Config{Dir: dir})
+	assert.Equal(t, DefaultInterval, store.Interval())
+
+	state := testState{Items: map[string]int{"a": 1, "b": 2}, Timestamp: time.Now().UTC().Truncate(time.Second)}
+	require.NoError(t, store.Save("test", state))
+
+	_, err := os.Stat(filepath.Join(dir, "test.json.gz"))
+	assert.NoError(t, err)
+
+	var loaded testState
+	found, err := store.Load("test", &loaded)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, state, loaded)
+
+	// No temp files are left behind
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestStore_LoadMissing(t *testing.T) {
+	store := NewStore(Config{Dir: t.TempDir()})
+
+	found, err := store.Load("missing", &testState{})
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_LoadCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(Config{Dir: dir})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json.gz"), []byte("not gzip"), 0o644))
+
+	found, err := store.Load("broken", &testState{})
+	assert.Error(t, err)
+	assert.False(t, found)
+}
+
+func TestSaver_SavesOnStop(t *testing.T) {
+	store := NewStore(Config{Dir: t.TempDir(), Interval: time.Hour})
+	calls := 0
+	saver := NewSaver(store, "test", func() (interface{}, error) {
+		calls++
+		return testState{Items: map[string]int{"a": calls}}, nil
+	})
+
+	saver.Start(context.Background())
+	saver.Stop()
+
+	var loaded testState
+	found, err := store.Load("test", &loaded)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 1, loaded.Items["a"])
+}
+
+func TestSaver_NilState(t *testing.T) {
+	store := NewStore(Config{Dir: t.TempDir()})
+	saver := NewSaver(store, "test", func() (interface{}, error) {
+		return nil, nil
+	})
+
+	require.NoError(t, saver.Save())
+	found, err := store.Load("test", &testState{})
+	assert.NoError(t, err)
+	assert.False(t, found)
+}